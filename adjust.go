@@ -0,0 +1,109 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+)
+
+// BusinessDayConvention determines how a date that is not a business day
+// (e.g. a scheduled payment date that falls on a weekend or holiday) is
+// rolled to a business day.
+type BusinessDayConvention int
+
+const (
+	// Unadjusted leaves the date unchanged, even if it is not a business day.
+	Unadjusted BusinessDayConvention = iota
+	// Following rolls the date forward to the next business day.
+	Following
+	// ModifiedFollowing rolls the date forward to the next business day,
+	// unless that falls in the next month, in which case the date is rolled
+	// backward to the previous business day.
+	ModifiedFollowing
+	// Preceding rolls the date backward to the previous business day.
+	Preceding
+	// ModifiedPreceding rolls the date backward to the previous business day,
+	// unless that falls in the previous month, in which case the date is
+	// rolled forward to the next business day.
+	ModifiedPreceding
+	// Nearest rolls the date to the nearest business day. If the previous and
+	// next business days are equally distant, the date is rolled forward.
+	Nearest
+)
+
+// String implements `fmt.Stringer`.
+func (bdc BusinessDayConvention) String() string {
+	switch bdc {
+	case Unadjusted:
+		return "Unadjusted"
+	case Following:
+		return "Following"
+	case ModifiedFollowing:
+		return "ModifiedFollowing"
+	case Preceding:
+		return "Preceding"
+	case ModifiedPreceding:
+		return "ModifiedPreceding"
+	case Nearest:
+		return "Nearest"
+	default:
+		return fmt.Sprintf("BusinessDayConvention(%d)", int(bdc))
+	}
+}
+
+// Adjust rolls a date to a business day in the calendar according to the
+// business day convention. If the date is already a business day (or the
+// convention is `Unadjusted` or unrecognized), it is returned unchanged.
+//
+// For example, with a Saturday / Sunday weekend and no holidays:
+// - `Following` adjusts 2024-08-31 (Saturday) to 2024-09-02
+// - `ModifiedFollowing` adjusts 2024-08-31 (Saturday) to 2024-08-30
+// - `Preceding` adjusts 2024-06-01 (Saturday) to 2024-05-31
+// - `ModifiedPreceding` adjusts 2024-06-01 (Saturday) to 2024-06-03
+// - `Nearest` adjusts 2024-06-01 (Saturday) to 2024-05-31
+// - `Nearest` adjusts 2024-06-02 (Sunday) to 2024-06-03
+func Adjust(d Date, calendar BusinessCalendar, convention BusinessDayConvention) Date {
+	if convention == Unadjusted || calendar.IsBusinessDay(d) {
+		return d
+	}
+
+	switch convention {
+	case Following:
+		return calendar.NextBusinessDay(d)
+	case ModifiedFollowing:
+		next := calendar.NextBusinessDay(d)
+		if next.After(d.MonthEnd()) {
+			return calendar.PreviousBusinessDay(d)
+		}
+		return next
+	case Preceding:
+		return calendar.PreviousBusinessDay(d)
+	case ModifiedPreceding:
+		previous := calendar.PreviousBusinessDay(d)
+		if previous.Before(d.MonthStart()) {
+			return calendar.NextBusinessDay(d)
+		}
+		return previous
+	case Nearest:
+		next := calendar.NextBusinessDay(d)
+		previous := calendar.PreviousBusinessDay(d)
+		if d.Sub(previous) < next.Sub(d) {
+			return previous
+		}
+		return next
+	default:
+		return d
+	}
+}
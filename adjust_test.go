@@ -0,0 +1,99 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestAdjust(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date       string
+		Convention date.BusinessDayConvention
+		Expected   string
+	}
+
+	cases := []testCase{
+		// Business day
+		{Date: "2024-08-30", Convention: date.Following, Expected: "2024-08-30"},
+		{Date: "2024-08-30", Convention: date.Preceding, Expected: "2024-08-30"},
+		// Saturday at month end
+		{Date: "2024-08-31", Convention: date.Unadjusted, Expected: "2024-08-31"},
+		{Date: "2024-08-31", Convention: date.Following, Expected: "2024-09-03"},
+		{Date: "2024-08-31", Convention: date.ModifiedFollowing, Expected: "2024-08-30"},
+		{Date: "2024-08-31", Convention: date.Preceding, Expected: "2024-08-30"},
+		{Date: "2024-08-31", Convention: date.ModifiedPreceding, Expected: "2024-08-30"},
+		{Date: "2024-08-31", Convention: date.Nearest, Expected: "2024-08-30"},
+		// Sunday at month end, followed by a holiday
+		{Date: "2024-09-01", Convention: date.Following, Expected: "2024-09-03"},
+		{Date: "2024-09-01", Convention: date.ModifiedFollowing, Expected: "2024-09-03"},
+		{Date: "2024-09-01", Convention: date.Preceding, Expected: "2024-08-30"},
+		{Date: "2024-09-01", Convention: date.ModifiedPreceding, Expected: "2024-09-03"},
+		{Date: "2024-09-01", Convention: date.Nearest, Expected: "2024-09-03"},
+		// Saturday at month start
+		{Date: "2024-06-01", Convention: date.Following, Expected: "2024-06-03"},
+		{Date: "2024-06-01", Convention: date.Preceding, Expected: "2024-05-31"},
+		{Date: "2024-06-01", Convention: date.ModifiedPreceding, Expected: "2024-06-03"},
+		{Date: "2024-06-01", Convention: date.Nearest, Expected: "2024-05-31"},
+		{Date: "2024-06-02", Convention: date.Nearest, Expected: "2024-06-03"},
+		// Holiday mid-week
+		{Date: "2024-12-25", Convention: date.Following, Expected: "2024-12-26"},
+		{Date: "2024-12-25", Convention: date.Preceding, Expected: "2024-12-24"},
+		{Date: "2024-12-25", Convention: date.Nearest, Expected: "2024-12-26"},
+		// Unrecognized convention
+		{Date: "2024-12-25", Convention: date.BusinessDayConvention(99), Expected: "2024-12-25"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s:%s", tc.Convention, tc.Date)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			c, err := date.NewCalendar(date.OptCalendarHolidays(
+				date.NewDate(2024, time.September, 2),
+				date.NewDate(2024, time.December, 25),
+			))
+			assert.Nil(err)
+
+			adjusted := date.Adjust(mustDate(assert, tc.Date), c, tc.Convention)
+			assert.Equal(tc.Expected, adjusted.String())
+		})
+	}
+}
+
+func TestBusinessDayConvention_String(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	assert.Equal("Unadjusted", date.Unadjusted.String())
+	assert.Equal("Following", date.Following.String())
+	assert.Equal("ModifiedFollowing", date.ModifiedFollowing.String())
+	assert.Equal("Preceding", date.Preceding.String())
+	assert.Equal("ModifiedPreceding", date.ModifiedPreceding.String())
+	assert.Equal("Nearest", date.Nearest.String())
+	assert.Equal("BusinessDayConvention(99)", date.BusinessDayConvention(99).String())
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"time"
+)
+
+// Adjuster adjusts a date, e.g. to the second Tuesday of its month or to the
+// next Monday. Methods such as `Date.MonthStart` and `Date.MonthEnd` (as method
+// expressions) and functions such as `FirstDayOfYear` are also adjusters.
+//
+// By convention, an adjuster returns an infinite date unchanged.
+type Adjuster func(Date) Date
+
+// Chain returns an adjuster that applies each of the adjusters in turn. For
+// example, `Chain(FirstDayOfNextMonth, NextOrSameWeekday(time.Monday))`
+// adjusts a date to the first Monday of the following month.
+func Chain(adjusters ...Adjuster) Adjuster {
+	return func(d Date) Date {
+		for _, adjuster := range adjusters {
+			d = adjuster(d)
+		}
+		return d
+	}
+}
+
+// With returns the date adjusted by each of the adjusters in turn, e.g.
+// `d.With(Date.MonthEnd, PreviousOrSameWeekday(time.Friday))` is the last
+// Friday of the month.
+func (d Date) With(adjusters ...Adjuster) Date {
+	return Chain(adjusters...)(d)
+}
+
+// NthWeekdayOfMonth returns an adjuster to the `n`th occurrence of a weekday in
+// the month of the date; a negative `n` counts from the end of the month (e.g.
+// -1 is the last occurrence).
+//
+// The result is always in the same month as the date. If the month has no
+// such occurrence (e.g. a 5th Monday) or `n` is 0, the date is unchanged.
+func NthWeekdayOfMonth(n int, weekday time.Weekday) Adjuster {
+	return func(d Date) Date {
+		if d.IsInfinite() {
+			return d
+		}
+
+		nth, ok := nthWeekdayOfMonth(d.Year, d.Month, weekday, n)
+		if !ok {
+			return d
+		}
+		return nth
+	}
+}
+
+// FirstWeekdayOfMonth returns an adjuster to the first occurrence of a weekday
+// in the month of the date.
+func FirstWeekdayOfMonth(weekday time.Weekday) Adjuster {
+	return NthWeekdayOfMonth(1, weekday)
+}
+
+// LastWeekdayOfMonth returns an adjuster to the last occurrence of a weekday
+// in the month of the date.
+func LastWeekdayOfMonth(weekday time.Weekday) Adjuster {
+	return NthWeekdayOfMonth(-1, weekday)
+}
+
+// NextWeekday returns an adjuster to the first occurrence of a weekday
+// strictly after the date.
+func NextWeekday(weekday time.Weekday) Adjuster {
+	return func(d Date) Date {
+		if d.IsInfinite() {
+			return d
+		}
+
+		offset := (int(weekday) - int(d.Weekday()) + 7) % 7
+		if offset == 0 {
+			offset = 7
+		}
+		return d.AddDays(offset)
+	}
+}
+
+// NextOrSameWeekday returns an adjuster to the first occurrence of a weekday
+// on or after the date.
+func NextOrSameWeekday(weekday time.Weekday) Adjuster {
+	return func(d Date) Date {
+		if d.IsInfinite() {
+			return d
+		}
+
+		offset := (int(weekday) - int(d.Weekday()) + 7) % 7
+		return d.AddDays(offset)
+	}
+}
+
+// PreviousWeekday returns an adjuster to the last occurrence of a weekday
+// strictly before the date.
+func PreviousWeekday(weekday time.Weekday) Adjuster {
+	return func(d Date) Date {
+		if d.IsInfinite() {
+			return d
+		}
+
+		offset := (int(d.Weekday()) - int(weekday) + 7) % 7
+		if offset == 0 {
+			offset = 7
+		}
+		return d.AddDays(-offset)
+	}
+}
+
+// PreviousOrSameWeekday returns an adjuster to the last occurrence of a
+// weekday on or before the date.
+func PreviousOrSameWeekday(weekday time.Weekday) Adjuster {
+	return func(d Date) Date {
+		if d.IsInfinite() {
+			return d
+		}
+
+		offset := (int(d.Weekday()) - int(weekday) + 7) % 7
+		return d.AddDays(-offset)
+	}
+}
+
+// FirstDayOfNextMonth adjusts a date to the first day of the following month.
+// An infinite date is returned unchanged.
+func FirstDayOfNextMonth(d Date) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	return d.MonthStart().AddMonths(1)
+}
+
+// FirstDayOfYear adjusts a date to January 1 of its year. An infinite date is
+// returned unchanged.
+func FirstDayOfYear(d Date) Date {
+	return d.YearStart()
+}
+
+// LastDayOfYear adjusts a date to December 31 of its year. An infinite date is
+// returned unchanged.
+func LastDayOfYear(d Date) Date {
+	return d.YearEnd()
+}
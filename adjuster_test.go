@@ -0,0 +1,231 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestAdjusters(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Description string
+		Date        string
+		Adjuster    date.Adjuster
+		Expected    string
+	}
+
+	cases := []testCase{
+		{
+			Description: "Second Tuesday",
+			Date:        "2024-05-28",
+			Adjuster:    date.NthWeekdayOfMonth(2, time.Tuesday),
+			Expected:    "2024-05-14",
+		},
+		{
+			Description: "First Wednesday (1st of month)",
+			Date:        "2024-05-28",
+			Adjuster:    date.FirstWeekdayOfMonth(time.Wednesday),
+			Expected:    "2024-05-01",
+		},
+		{
+			Description: "Fifth Friday (exists)",
+			Date:        "2024-05-01",
+			Adjuster:    date.NthWeekdayOfMonth(5, time.Friday),
+			Expected:    "2024-05-31",
+		},
+		{
+			Description: "Fifth Monday (does not exist)",
+			Date:        "2024-05-01",
+			Adjuster:    date.NthWeekdayOfMonth(5, time.Monday),
+			Expected:    "2024-05-01",
+		},
+		{
+			Description: "Fifth Monday in February (does not exist)",
+			Date:        "2024-02-01",
+			Adjuster:    date.NthWeekdayOfMonth(5, time.Monday),
+			Expected:    "2024-02-01",
+		},
+		{
+			Description: "Sixth to last Sunday (does not exist)",
+			Date:        "2024-03-15",
+			Adjuster:    date.NthWeekdayOfMonth(-6, time.Sunday),
+			Expected:    "2024-03-15",
+		},
+		{
+			Description: "Second to last Thursday",
+			Date:        "2024-02-01",
+			Adjuster:    date.NthWeekdayOfMonth(-2, time.Thursday),
+			Expected:    "2024-02-22",
+		},
+		{
+			Description: "Zeroth weekday",
+			Date:        "2024-02-07",
+			Adjuster:    date.NthWeekdayOfMonth(0, time.Thursday),
+			Expected:    "2024-02-07",
+		},
+		{
+			Description: "Last Friday",
+			Date:        "2024-05-01",
+			Adjuster:    date.LastWeekdayOfMonth(time.Friday),
+			Expected:    "2024-05-31",
+		},
+		{
+			Description: "Last Monday",
+			Date:        "2024-05-31",
+			Adjuster:    date.LastWeekdayOfMonth(time.Monday),
+			Expected:    "2024-05-27",
+		},
+		{
+			Description: "Next Monday (from Monday)",
+			Date:        "2024-05-27",
+			Adjuster:    date.NextWeekday(time.Monday),
+			Expected:    "2024-06-03",
+		},
+		{
+			Description: "Next Monday (from Saturday)",
+			Date:        "2024-06-01",
+			Adjuster:    date.NextWeekday(time.Monday),
+			Expected:    "2024-06-03",
+		},
+		{
+			Description: "Next or same Monday (from Monday)",
+			Date:        "2024-05-27",
+			Adjuster:    date.NextOrSameWeekday(time.Monday),
+			Expected:    "2024-05-27",
+		},
+		{
+			Description: "Next or same Monday (from Tuesday)",
+			Date:        "2024-05-28",
+			Adjuster:    date.NextOrSameWeekday(time.Monday),
+			Expected:    "2024-06-03",
+		},
+		{
+			Description: "Previous Friday (from Friday)",
+			Date:        "2024-05-31",
+			Adjuster:    date.PreviousWeekday(time.Friday),
+			Expected:    "2024-05-24",
+		},
+		{
+			Description: "Previous Friday (from Monday)",
+			Date:        "2024-06-03",
+			Adjuster:    date.PreviousWeekday(time.Friday),
+			Expected:    "2024-05-31",
+		},
+		{
+			Description: "Previous or same Friday (from Friday)",
+			Date:        "2024-05-31",
+			Adjuster:    date.PreviousOrSameWeekday(time.Friday),
+			Expected:    "2024-05-31",
+		},
+		{
+			Description: "First day of next month",
+			Date:        "2024-01-31",
+			Adjuster:    date.FirstDayOfNextMonth,
+			Expected:    "2024-02-01",
+		},
+		{
+			Description: "First day of next month (December)",
+			Date:        "2024-12-15",
+			Adjuster:    date.FirstDayOfNextMonth,
+			Expected:    "2025-01-01",
+		},
+		{
+			Description: "First day of year",
+			Date:        "2024-05-28",
+			Adjuster:    date.FirstDayOfYear,
+			Expected:    "2024-01-01",
+		},
+		{
+			Description: "Last day of year",
+			Date:        "2024-05-28",
+			Adjuster:    date.LastDayOfYear,
+			Expected:    "2024-12-31",
+		},
+		{
+			Description: "Method expression",
+			Date:        "2024-02-10",
+			Adjuster:    date.Date.MonthEnd,
+			Expected:    "2024-02-29",
+		},
+		{
+			Description: "Chain: last Friday of the month",
+			Date:        "2024-02-10",
+			Adjuster:    date.Chain(date.Date.MonthEnd, date.PreviousOrSameWeekday(time.Friday)),
+			Expected:    "2024-02-23",
+		},
+		{
+			Description: "Chain: first Monday of next month",
+			Date:        "2024-06-15",
+			Adjuster:    date.Chain(date.FirstDayOfNextMonth, date.NextOrSameWeekday(time.Monday)),
+			Expected:    "2024-07-01",
+		},
+		{
+			Description: "Chain: empty",
+			Date:        "2024-06-15",
+			Adjuster:    date.Chain(),
+			Expected:    "2024-06-15",
+		},
+		{
+			Description: "Infinite",
+			Date:        "infinity",
+			Adjuster: date.Chain(
+				date.NthWeekdayOfMonth(2, time.Tuesday),
+				date.NextWeekday(time.Monday),
+				date.PreviousOrSameWeekday(time.Friday),
+				date.FirstDayOfNextMonth,
+				date.LastDayOfYear,
+			),
+			Expected: "infinity",
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := mustDate(assert, tc.Date)
+			assert.Equal(tc.Expected, tc.Adjuster(d).String())
+			assert.Equal(tc.Expected, d.With(tc.Adjuster).String())
+		})
+	}
+}
+
+func TestDate_With(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(2024, time.March, 14)
+	assert.Equal(d, d.With())
+
+	// Thanksgiving, then the following Monday.
+	adjusted := d.With(
+		date.Date.MonthStart,
+		func(d date.Date) date.Date { return d.AddMonths(8) },
+		date.NthWeekdayOfMonth(4, time.Thursday),
+		date.NextWeekday(time.Monday),
+	)
+	assert.Equal(date.NewDate(2024, time.December, 2), adjusted)
+}
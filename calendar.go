@@ -0,0 +1,280 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// NOTE: Ensure that
+// - `*Calendar` satisfies `BusinessCalendar`.
+var (
+	_ BusinessCalendar = (*Calendar)(nil)
+)
+
+// BusinessCalendar determines which dates are business days (e.g. days when
+// banks are open) and provides helpers for moving between business days.
+type BusinessCalendar interface {
+	// IsBusinessDay returns true if the date is a business day.
+	IsBusinessDay(d Date) bool
+	// AddBusinessDays returns the date `n` business days after `d` (or before
+	// `d` if `n` is negative). The date `d` need not be a business day; if
+	// `n` is zero, `d` is returned unchanged.
+	AddBusinessDays(d Date, n int) Date
+	// NextBusinessDay returns the first business day strictly after `d`.
+	NextBusinessDay(d Date) Date
+	// PreviousBusinessDay returns the last business day strictly before `d`.
+	PreviousBusinessDay(d Date) Date
+	// BusinessDaysBetween returns the number of business days in the
+	// half-open range `[a, b)`. If `b` is before `a` this is the negative of
+	// the number of business days in `[b, a)`.
+	BusinessDaysBetween(a, b Date) int64
+}
+
+// CalendarConfig helps customize the behavior of `NewCalendar()`.
+type CalendarConfig struct {
+	Weekend     []time.Weekday
+	Holidays    []Date
+	HolidaySets []HolidaySet
+}
+
+// CalendarOption defines a function that will be applied to a calendar
+// config.
+type CalendarOption func(*CalendarConfig)
+
+// OptCalendarWeekend returns an option that sets the weekend days on a
+// calendar config, e.g. `time.Friday` and `time.Saturday`. This replaces
+// the default weekend of Saturday and Sunday.
+func OptCalendarWeekend(days ...time.Weekday) CalendarOption {
+	return func(cc *CalendarConfig) {
+		cc.Weekend = days
+	}
+}
+
+// OptCalendarHolidays returns an option that adds holidays to a calendar
+// config.
+func OptCalendarHolidays(holidays ...Date) CalendarOption {
+	return func(cc *CalendarConfig) {
+		cc.Holidays = append(cc.Holidays, holidays...)
+	}
+}
+
+// OptCalendarHolidaySets returns an option that adds rule-based holiday sets
+// (e.g. `NYSEHolidays()`) to a calendar config.
+func OptCalendarHolidaySets(sets ...HolidaySet) CalendarOption {
+	return func(cc *CalendarConfig) {
+		cc.HolidaySets = append(cc.HolidaySets, sets...)
+	}
+}
+
+// Calendar is an in-memory `BusinessCalendar` that combines a weekend
+// definition with a set of holidays. A date is a business day if it is
+// neither a weekend day nor a holiday.
+type Calendar struct {
+	weekend     [7]bool
+	holidays    map[Date]struct{}
+	holidaySets []HolidaySet
+}
+
+// NewCalendar returns a new `Calendar`. By default, the weekend is Saturday
+// and Sunday and there are no holidays.
+//
+// An error is returned if the weekend contains every day of the week (or an
+// invalid weekday) since such a calendar would have no business days.
+func NewCalendar(opts ...CalendarOption) (*Calendar, error) {
+	cc := CalendarConfig{Weekend: []time.Weekday{time.Saturday, time.Sunday}}
+	for _, opt := range opts {
+		opt(&cc)
+	}
+
+	c := &Calendar{
+		holidays:    make(map[Date]struct{}, len(cc.Holidays)),
+		holidaySets: cc.HolidaySets,
+	}
+	weekendDays := 0
+	for _, day := range cc.Weekend {
+		if day < time.Sunday || day > time.Saturday {
+			return nil, fmt.Errorf("invalid weekday for calendar weekend; weekday=%d", day)
+		}
+		if !c.weekend[day] {
+			weekendDays++
+		}
+		c.weekend[day] = true
+	}
+	if weekendDays == len(c.weekend) {
+		return nil, fmt.Errorf("calendar weekend cannot contain every day of the week")
+	}
+
+	for _, holiday := range cc.Holidays {
+		c.holidays[holiday] = struct{}{}
+	}
+
+	return c, nil
+}
+
+// weekendDaysCalendar is a `BusinessCalendar` with a fixed set of weekend days.
+type weekendDaysCalendar interface {
+	weekendDays() [7]bool
+}
+
+// weekendDays returns the weekend days of the calendar, indexed by weekday.
+func (c *Calendar) weekendDays() [7]bool {
+	return c.weekend
+}
+
+// IsWeekend returns true if the date falls on a weekend day.
+func (c *Calendar) IsWeekend(d Date) bool {
+	return c.weekend[d.Weekday()]
+}
+
+// IsHoliday returns true if the date is a holiday, either directly or via one
+// of the calendar's holiday sets.
+func (c *Calendar) IsHoliday(d Date) bool {
+	if _, ok := c.holidays[d]; ok {
+		return true
+	}
+
+	for _, hs := range c.holidaySets {
+		if hs.IsHoliday(d) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBusinessDay returns true if the date is neither a weekend day nor a
+// holiday.
+func (c *Calendar) IsBusinessDay(d Date) bool {
+	return !c.IsWeekend(d) && !c.IsHoliday(d)
+}
+
+// AddBusinessDays returns the date `n` business days after `d` (or before
+// `d` if `n` is negative).
+func (c *Calendar) AddBusinessDays(d Date, n int) Date {
+	return addBusinessDays(c, d, n)
+}
+
+// NextBusinessDay returns the first business day strictly after `d`.
+func (c *Calendar) NextBusinessDay(d Date) Date {
+	return nextBusinessDay(c, d)
+}
+
+// PreviousBusinessDay returns the last business day strictly before `d`.
+func (c *Calendar) PreviousBusinessDay(d Date) Date {
+	return previousBusinessDay(c, d)
+}
+
+// BusinessDaysBetween returns the number of business days in the half-open
+// range `[a, b)`. If `b` is before `a` this is the negative of the number of
+// business days in `[b, a)`.
+//
+// This counts weekdays arithmetically (rather than visiting each date in the
+// range) and then removes holidays that fall in the range. If either date is
+// infinite (and the dates differ), this saturates to `math.MaxInt64` (or its
+// negative).
+func (c *Calendar) BusinessDaysBetween(a, b Date) int64 {
+	if b.Before(a) {
+		return -c.BusinessDaysBetween(b, a)
+	}
+	if a.Equal(b) {
+		return 0
+	}
+	if a.IsInfinite() || b.IsInfinite() {
+		return math.MaxInt64
+	}
+
+	total := b.Sub(a)
+	fullWeeks := total / 7
+	remainder := total % 7
+	startWeekday := int64(a.Weekday())
+
+	count := int64(0)
+	for day := int64(0); day < 7; day++ {
+		if c.weekend[day] {
+			continue
+		}
+
+		count += fullWeeks
+		if (day-startWeekday+7)%7 < remainder {
+			count++
+		}
+	}
+
+	for holiday := range c.holidaysBetween(a, b) {
+		if !c.IsWeekend(holiday) {
+			count--
+		}
+	}
+
+	return count
+}
+
+// holidaysBetween returns the (de-duplicated) holidays in the half-open range
+// `[a, b)`.
+func (c *Calendar) holidaysBetween(a, b Date) map[Date]struct{} {
+	result := map[Date]struct{}{}
+	for holiday := range c.holidays {
+		if !holiday.Before(a) && holiday.Before(b) {
+			result[holiday] = struct{}{}
+		}
+	}
+
+	for _, hs := range c.holidaySets {
+		for year := a.Year; year <= b.Year; year++ {
+			for _, holiday := range hs.Holidays(year) {
+				if !holiday.Before(a) && holiday.Before(b) {
+					result[holiday] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// businessDayer is the subset of `BusinessCalendar` needed to implement the
+// remaining methods generically.
+type businessDayer interface {
+	IsBusinessDay(d Date) bool
+}
+
+func nextBusinessDay(bd businessDayer, d Date) Date {
+	next := d.AddDays(1)
+	for !bd.IsBusinessDay(next) {
+		next = next.AddDays(1)
+	}
+	return next
+}
+
+func previousBusinessDay(bd businessDayer, d Date) Date {
+	previous := d.AddDays(-1)
+	for !bd.IsBusinessDay(previous) {
+		previous = previous.AddDays(-1)
+	}
+	return previous
+}
+
+func addBusinessDays(bd businessDayer, d Date, n int) Date {
+	for ; n > 0; n-- {
+		d = nextBusinessDay(bd, d)
+	}
+	for ; n < 0; n++ {
+		d = previousBusinessDay(bd, d)
+	}
+	return d
+}
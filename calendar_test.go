@@ -0,0 +1,258 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func mustDate(assert *testifyrequire.Assertions, s string) date.Date {
+	d, err := date.FromString(s)
+	assert.Nil(err)
+	return d
+}
+
+func TestNewCalendar(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	// Default weekend
+	c, err := date.NewCalendar()
+	assert.Nil(err)
+	assert.True(c.IsWeekend(mustDate(assert, "2024-03-02")))
+	assert.True(c.IsWeekend(mustDate(assert, "2024-03-03")))
+	assert.False(c.IsWeekend(mustDate(assert, "2024-03-01")))
+	assert.False(c.IsHoliday(mustDate(assert, "2024-03-01")))
+
+	// Friday / Saturday weekend
+	c, err = date.NewCalendar(date.OptCalendarWeekend(time.Friday, time.Saturday))
+	assert.Nil(err)
+	assert.True(c.IsWeekend(mustDate(assert, "2024-03-01")))
+	assert.True(c.IsWeekend(mustDate(assert, "2024-03-02")))
+	assert.False(c.IsWeekend(mustDate(assert, "2024-03-03")))
+
+	// Invalid weekday
+	c, err = date.NewCalendar(date.OptCalendarWeekend(time.Weekday(7)))
+	assert.Nil(c)
+	assert.NotNil(err)
+	assert.Equal("invalid weekday for calendar weekend; weekday=7", fmt.Sprintf("%v", err))
+
+	// No business days
+	everyDay := []time.Weekday{
+		time.Sunday, time.Monday, time.Tuesday, time.Wednesday,
+		time.Thursday, time.Friday, time.Saturday, time.Sunday,
+	}
+	c, err = date.NewCalendar(date.OptCalendarWeekend(everyDay...))
+	assert.Nil(c)
+	assert.NotNil(err)
+	assert.Equal("calendar weekend cannot contain every day of the week", fmt.Sprintf("%v", err))
+}
+
+func TestCalendar_IsBusinessDay(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date     string
+		Expected bool
+	}
+
+	cases := []testCase{
+		{Date: "2024-12-23", Expected: true},
+		{Date: "2024-12-24", Expected: true},
+		{Date: "2024-12-25", Expected: false},
+		{Date: "2024-12-27", Expected: true},
+		{Date: "2024-12-28", Expected: false},
+		{Date: "2024-12-29", Expected: false},
+		{Date: "2025-01-01", Expected: false},
+		{Date: "2025-01-02", Expected: true},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			c, err := date.NewCalendar(date.OptCalendarHolidays(
+				date.NewDate(2024, time.December, 25),
+				date.NewDate(2025, time.January, 1),
+			))
+			assert.Nil(err)
+
+			assert.Equal(tc.Expected, c.IsBusinessDay(mustDate(assert, tc.Date)))
+		})
+	}
+}
+
+func TestCalendar_AddBusinessDays(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date     string
+		Delta    int
+		Expected string
+	}
+
+	cases := []testCase{
+		{Date: "2024-12-20", Delta: 0, Expected: "2024-12-20"},
+		{Date: "2024-12-21", Delta: 0, Expected: "2024-12-21"},
+		{Date: "2024-12-20", Delta: 1, Expected: "2024-12-23"},
+		{Date: "2024-12-21", Delta: 1, Expected: "2024-12-23"},
+		{Date: "2024-12-24", Delta: 1, Expected: "2024-12-26"},
+		{Date: "2024-12-24", Delta: 5, Expected: "2025-01-02"},
+		{Date: "2024-12-26", Delta: -1, Expected: "2024-12-24"},
+		{Date: "2025-01-02", Delta: -5, Expected: "2024-12-24"},
+		{Date: "2024-12-22", Delta: -1, Expected: "2024-12-20"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s + %d -> %s", tc.Date, tc.Delta, tc.Expected)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			c, err := date.NewCalendar(date.OptCalendarHolidays(
+				date.NewDate(2024, time.December, 25),
+				date.NewDate(2025, time.January, 1),
+			))
+			assert.Nil(err)
+
+			computed := c.AddBusinessDays(mustDate(assert, tc.Date), tc.Delta)
+			assert.Equal(tc.Expected, computed.String())
+		})
+	}
+}
+
+func TestCalendar_NextBusinessDay(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	c, err := date.NewCalendar(
+		date.OptCalendarWeekend(time.Friday, time.Saturday),
+		date.OptCalendarHolidays(date.NewDate(2024, time.April, 10)),
+	)
+	assert.Nil(err)
+
+	// Thursday -> Sunday
+	assert.Equal("2024-04-07", c.NextBusinessDay(mustDate(assert, "2024-04-04")).String())
+	// Friday -> Sunday
+	assert.Equal("2024-04-07", c.NextBusinessDay(mustDate(assert, "2024-04-05")).String())
+	// Tuesday -> Thursday (skip holiday)
+	assert.Equal("2024-04-11", c.NextBusinessDay(mustDate(assert, "2024-04-09")).String())
+
+	// Sunday -> Thursday
+	assert.Equal("2024-04-04", c.PreviousBusinessDay(mustDate(assert, "2024-04-07")).String())
+	// Saturday -> Thursday
+	assert.Equal("2024-04-04", c.PreviousBusinessDay(mustDate(assert, "2024-04-06")).String())
+	// Thursday -> Tuesday (skip holiday)
+	assert.Equal("2024-04-09", c.PreviousBusinessDay(mustDate(assert, "2024-04-11")).String())
+}
+
+func TestCalendar_BusinessDaysBetween_Infinite(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	c, err := date.NewCalendar()
+	assert.Nil(err)
+
+	d := date.NewDate(2024, time.December, 2)
+	assert.Equal(int64(math.MaxInt64), c.BusinessDaysBetween(d, date.Infinity))
+	assert.Equal(int64(-math.MaxInt64), c.BusinessDaysBetween(d, date.NegativeInfinity))
+	assert.Equal(int64(math.MaxInt64), c.BusinessDaysBetween(date.NegativeInfinity, d))
+	assert.Equal(int64(0), c.BusinessDaysBetween(date.Infinity, date.Infinity))
+}
+
+func TestCalendar_BusinessDaysBetween(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Start    string
+		End      string
+		Weekend  []time.Weekday
+		Expected int64
+	}
+
+	cases := []testCase{
+		{Start: "2024-12-02", End: "2024-12-02", Expected: 0},
+		{Start: "2024-12-02", End: "2024-12-03", Expected: 1},
+		{Start: "2024-12-02", End: "2024-12-09", Expected: 5},
+		{Start: "2024-12-07", End: "2024-12-09", Expected: 0},
+		{Start: "2024-12-06", End: "2024-12-10", Expected: 2},
+		{Start: "2024-12-01", End: "2025-01-01", Expected: 21},
+		{Start: "2024-12-01", End: "2025-01-02", Expected: 21},
+		{Start: "2025-01-02", End: "2024-12-01", Expected: -21},
+		{Start: "2024-01-01", End: "2025-01-01", Expected: 261},
+		{Start: "2024-12-01", End: "2025-01-01", Weekend: []time.Weekday{time.Friday, time.Saturday}, Expected: 22},
+		{Start: "2024-12-06", End: "2024-12-10", Weekend: []time.Weekday{time.Friday, time.Saturday}, Expected: 2},
+		{Start: "2024-12-06", End: "2024-12-10", Weekend: []time.Weekday{time.Sunday}, Expected: 3},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s - %s %v", tc.Start, tc.End, tc.Weekend)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			opts := []date.CalendarOption{
+				date.OptCalendarHolidays(
+					date.NewDate(2024, time.December, 25),
+					date.NewDate(2024, time.December, 28),
+					date.NewDate(2025, time.January, 1),
+				),
+			}
+			if tc.Weekend != nil {
+				opts = append(opts, date.OptCalendarWeekend(tc.Weekend...))
+			}
+			c, err := date.NewCalendar(opts...)
+			assert.Nil(err)
+
+			start := mustDate(assert, tc.Start)
+			end := mustDate(assert, tc.End)
+			computed := c.BusinessDaysBetween(start, end)
+			assert.Equal(tc.Expected, computed)
+
+			// Compare to a brute force count
+			lower, upper := start, end
+			if upper.Before(lower) {
+				lower, upper = upper, lower
+			}
+			count := int64(0)
+			for d := lower; d.Before(upper); d = d.AddDays(1) {
+				if c.IsBusinessDay(d) {
+					count++
+				}
+			}
+			if end.Before(start) {
+				count = -count
+			}
+			assert.Equal(count, computed)
+		})
+	}
+}
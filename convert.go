@@ -100,20 +100,23 @@ func NullTimeFromPtr(d *Date, opts ...ConvertOption) sql.NullTime {
 	return sql.NullTime{Time: t, Valid: true}
 }
 
-// FromString parses a string of the form YYYY-MM-DD into a `Date{}`.
+// FromString parses a string of the form YYYY-MM-DD into a `Date{}`. The
+// strings `infinity` and `-infinity` (as used by Postgres) are parsed as
+// `Infinity` and `NegativeInfinity`.
+//
+// If the string cannot be parsed, the error is a `*ParseError`; if the month
+// or day is out of range (e.g. 2024-02-30), it also matches `ErrInvalidDate`.
 func FromString(s string) (Date, error) {
-	t, err := time.Parse(time.DateOnly, s)
-	if err != nil {
-		return Date{}, err
+	if d, ok := infiniteFromString(s); ok {
+		return d, nil
 	}
 
-	year, month, day := t.Date()
-	d := Date{Year: year, Month: month, Day: day}
-	return d, nil
+	return parse(time.DateOnly, s)
 }
 
 // FromTime validates that a `time.Time{}` contains a date and converts it to a
-// `Date{}`.
+// `Date{}`. If the timestamp has a non-zero time of day or is not in UTC, an
+// error wrapping `ErrNotDateOnly` is returned.
 func FromTime(t time.Time) (Date, error) {
 	_, offset := t.Zone()
 
@@ -122,7 +125,7 @@ func FromTime(t time.Time) (Date, error) {
 		t.Second() != 0 ||
 		t.Nanosecond() != 0 ||
 		offset != 0 {
-		return Date{}, fmt.Errorf("timestamp contains more than just date information; %s", t.Format(time.RFC3339Nano))
+		return Date{}, fmt.Errorf("%w; %s", ErrNotDateOnly, t.Format(time.RFC3339Nano))
 	}
 
 	year, month, day := t.Date()
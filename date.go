@@ -58,9 +58,13 @@ func NewDate(year int, month time.Month, day int) Date {
 }
 
 // AddDays returns the date corresponding to adding the given number of days.
+// An infinite date is returned unchanged.
 func (d Date) AddDays(days int) Date {
-	t := d.ToTime().AddDate(0, 0, days)
-	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+	if d.IsInfinite() {
+		return d
+	}
+
+	return FromEpochDays(d.EpochDays() + int64(days))
 }
 
 // AddMonths returns the date corresponding to adding the given number of
@@ -74,9 +78,15 @@ func (d Date) AddDays(days int) Date {
 // - adding 3 months to 2024-01-31 results in 2024-04-30
 // - subtracting 2 months from 2022-01-31 results in 2022-11-30
 //
+// An infinite date is returned unchanged.
+//
 // NOTE: This behavior is very similar to but distinct from
 // `time.Time{}.AddDate()` specialized to `months` only.
 func (d Date) AddMonths(months int) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
 	updatedMonth, yearDelta := monthsChange(d.Month, months)
 	updatedYear := d.Year + yearDelta
 	updatedDay := minInt(d.Day, daysIn(updatedMonth, updatedYear))
@@ -93,7 +103,13 @@ func (d Date) AddMonths(months int) Date {
 // - adding 1 month to 2022-01-31 results in 2022-03-03
 // - adding 3 months to 2024-01-31 results in 2024-05-01
 // - subtracting 2 months from 2022-01-31 results in 2022-12-01
+//
+// An infinite date is returned unchanged.
 func (d Date) AddMonthsStdlib(months int) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
 	t := d.ToTime().AddDate(0, months, 0)
 	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
 }
@@ -121,9 +137,15 @@ func monthsChange(month time.Month, monthDelta int) (time.Month, int) {
 // - adding 10 years to 2010-05-01 results in 2020-05-01
 // - subtracting 10 years from 2010-05-01 results in 2000-05-01
 //
+// An infinite date is returned unchanged.
+//
 // NOTE: This behavior is very similar to but distinct from
 // `time.Time{}.AddDate()` specialized to `years` only.
 func (d Date) AddYears(years int) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
 	updatedMonth := d.Month
 	updatedYear := d.Year + years
 	updatedDay := minInt(d.Day, daysIn(updatedMonth, updatedYear))
@@ -141,50 +163,141 @@ func (d Date) AddYears(years int) Date {
 // - adding 10 years to 2010-05-01 results in 2020-05-01
 // - subtracting 10 years from 2010-05-01 results in 2000-05-01
 //
+// An infinite date is returned unchanged.
+//
 // NOTE: This behavior is very similar to but distinct from
 // `time.Time{}.AddDate()` specialized to `years` only.
 func (d Date) AddYearsStdlib(years int) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
 	t := d.ToTime().AddDate(years, 0, 0)
 	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
 }
 
-// Sub returns the number of days `d - other`; this converts both dates to
-// a `time.Time{}` UTC and then dispatches to `time.Time{}.Sub()`.
+// Sub returns the number of days `d - other`. This is computed exactly with
+// integer calendar arithmetic (see `EpochDays()`), so it is valid for dates
+// that are arbitrarily far apart. This panics if `SubErr()` returns an error.
 func (d Date) Sub(other Date) int64 {
 	days, err := d.SubErr(other)
 	mustNil(err)
-	return int64(days)
+	return days
 }
 
-// SubErr returns the number of days `d - other`; this converts both dates to
-// a `time.Time{}` UTC and then dispatches to `time.Time{}.Sub()`.
+// SubErr returns the number of days `d - other`. This is computed exactly with
+// integer calendar arithmetic (see `EpochDays()`), so it is valid for dates
+// that are arbitrarily far apart.
 //
-// If the number of days is not a whole number (due to overflow), an error is
-// returned.
+// If either date is infinite, an error wrapping `ErrSubInfinite` is returned.
+// If the year, month or day of either date exceeds 10^15 in magnitude (so the
+// result may overflow), an error wrapping `ErrSubOutOfRange` is returned.
 func (d Date) SubErr(other Date) (int64, error) {
-	duration := d.ToTime().Sub(other.ToTime())
+	if d.IsInfinite() || other.IsInfinite() {
+		return 0, fmt.Errorf("%w; date=%s, other=%s", ErrSubInfinite, d, other)
+	}
 
-	day := 24 * time.Hour
-	days := duration / day
-	remainder := duration % day
-	if remainder != 0 {
-		return 0, fmt.Errorf("duration is not a whole number of days; duration=%s", duration)
+	if !inSubRange(d) || !inSubRange(other) {
+		return 0, fmt.Errorf("%w; date=%s, other=%s", ErrSubOutOfRange, d.GoString(), other.GoString())
 	}
 
-	return int64(days), nil
+	return d.EpochDays() - other.EpochDays(), nil
 }
 
-// MonthStart returns the first date in the month of the current date.
+// MonthStart returns the first date in the month of the current date. An
+// infinite date is returned unchanged.
 func (d Date) MonthStart() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
 	return Date{Year: d.Year, Month: d.Month, Day: 1}
 }
 
-// MonthEnd returns the last date in the month of the current date.
+// MonthEnd returns the last date in the month of the current date. An
+// infinite date is returned unchanged.
 func (d Date) MonthEnd() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
 	endDay := daysIn(d.Month, d.Year)
 	return Date{Year: d.Year, Month: d.Month, Day: endDay}
 }
 
+// Quarter returns the quarter of the year (1 through 4) of the current date.
+func (d Date) Quarter() int {
+	return (int(d.Month)-1)/3 + 1
+}
+
+// QuarterStart returns the first date in the quarter of the current date. An
+// infinite date is returned unchanged.
+func (d Date) QuarterStart() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	month := time.Month(3*(d.Quarter()-1) + 1)
+	return Date{Year: d.Year, Month: month, Day: 1}
+}
+
+// QuarterEnd returns the last date in the quarter of the current date. An
+// infinite date is returned unchanged.
+func (d Date) QuarterEnd() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	month := time.Month(3 * d.Quarter())
+	return Date{Year: d.Year, Month: month, Day: daysIn(month, d.Year)}
+}
+
+// HalfStart returns the first date in the half-year of the current date, i.e.
+// January 1 or July 1. An infinite date is returned unchanged.
+func (d Date) HalfStart() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	if d.Month <= time.June {
+		return Date{Year: d.Year, Month: time.January, Day: 1}
+	}
+	return Date{Year: d.Year, Month: time.July, Day: 1}
+}
+
+// HalfEnd returns the last date in the half-year of the current date, i.e.
+// June 30 or December 31. An infinite date is returned unchanged.
+func (d Date) HalfEnd() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	if d.Month <= time.June {
+		return Date{Year: d.Year, Month: time.June, Day: 30}
+	}
+	return Date{Year: d.Year, Month: time.December, Day: 31}
+}
+
+// YearStart returns the first date in the year of the current date. An
+// infinite date is returned unchanged.
+func (d Date) YearStart() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	return Date{Year: d.Year, Month: time.January, Day: 1}
+}
+
+// YearEnd returns the last date in the year of the current date. An infinite
+// date is returned unchanged.
+func (d Date) YearEnd() Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	return Date{Year: d.Year, Month: time.December, Day: 31}
+}
+
 // Before returns true if the date is before the other date.
 func (d Date) Before(other Date) bool {
 	if d.Year != other.Year {
@@ -269,21 +382,34 @@ func (d Date) ISOWeek() (year, week int) {
 
 // Weekday returns the day of the week specified by `d`.
 func (d Date) Weekday() time.Weekday {
-	return d.ToTime().Weekday()
+	// NOTE: The Unix epoch (1970-01-01) was a Thursday.
+	weekday := (d.EpochDays() + int64(time.Thursday)) % 7
+	if weekday < 0 {
+		weekday += 7
+	}
+	return time.Weekday(weekday)
 }
 
 // YearDay returns the day of the year specified by `d`, in the range [1,365]
 // for non-leap years, and [1,366] in leap years.
 func (d Date) YearDay() int {
-	return d.ToTime().YearDay()
+	// NOTE: Round trip through epoch days to normalize the date.
+	normalized := FromEpochDays(d.EpochDays())
+	yearDay := int(daysBefore[normalized.Month-1]) + normalized.Day
+	if normalized.Month > time.February && isLeap(normalized.Year) {
+		yearDay++
+	}
+	return yearDay
 }
 
-// MarshalText implements the encoding.TextMarshaler interface.
+// MarshalText implements the encoding.TextMarshaler interface. To return an
+// error for an invalid date, use `StrictDate{}`.
 func (d Date) MarshalText() ([]byte, error) {
 	return []byte(d.String()), nil
 }
 
-// MarshalJSON implements `json.Marshaler`; formats the date as YYYY-MM-DD.
+// MarshalJSON implements `json.Marshaler`; formats the date as YYYY-MM-DD. To
+// return an error for an invalid date, use `StrictDate{}`.
 func (d Date) MarshalJSON() ([]byte, error) {
 	s := d.String()
 	return json.Marshal(s)
@@ -318,19 +444,26 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Scan implements `sql.Scanner`; it unmarshals values of the type `time.Time`
-// onto the current `Date` struct.
+// Scan implements `sql.Scanner`; it unmarshals values of the type `time.Time`,
+// `string` or `[]byte` onto the current `Date` struct. A `string` or `[]byte`
+// value must be of the form YYYY-MM-DD or a datetime with an all-zero time
+// portion (e.g. 2024-01-01 00:00:00), as provided by drivers such as MySQL
+// (without `parseTime=true`) and SQLite.
 func (d *Date) Scan(src any) error {
-	var t time.Time
+	var verified Date
+	var err error
 
 	switch srcTyped := src.(type) {
 	case time.Time:
-		t = srcTyped
+		verified, err = FromTime(srcTyped)
+	case string:
+		verified, err = fromDatabaseString(srcTyped)
+	case []byte:
+		verified, err = fromDatabaseString(string(srcTyped))
 	default:
 		return fmt.Errorf("incompatible type for Date; type=%T", src)
 	}
 
-	verified, err := FromTime(t)
 	if err != nil {
 		return err
 	}
@@ -340,8 +473,14 @@ func (d *Date) Scan(src any) error {
 }
 
 // Value implements `driver.Valuer`; it marshals the value to a `time.Time`
-// to be serialized into the database.
+// to be serialized into the database. For a TEXT column, use `TextDate{}`
+// instead. An infinite date is always marshaled to the string `infinity` or
+// `-infinity`. To return an error for an invalid date, use `StrictDate{}`.
 func (d Date) Value() (driver.Value, error) {
+	if d.IsInfinite() {
+		return d.String(), nil
+	}
+
 	return d.ToTime(), nil
 }
 
@@ -352,12 +491,27 @@ func (d Date) String() string {
 
 // Format returns a textual representation of the date value formatted according
 // to the provided layout. This uses `time.Time{}.Format()` directly and is
-// provided here for convenience.
+// provided here for convenience. An infinite date is always formatted as
+// `infinity` or `-infinity`.
 func (d Date) Format(layout string) string {
+	switch d {
+	case Infinity:
+		return infinityString
+	case NegativeInfinity:
+		return negativeInfinityString
+	}
+
 	return d.ToTime().Format(layout)
 }
 
 // GoString implements `fmt.GoStringer`.
 func (d Date) GoString() string {
+	switch d {
+	case Infinity:
+		return "date.Infinity"
+	case NegativeInfinity:
+		return "date.NegativeInfinity"
+	}
+
 	return fmt.Sprintf("date.NewDate(%d, time.%s, %d)", d.Year, d.Month, d.Day)
 }
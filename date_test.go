@@ -16,6 +16,7 @@ package date_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -298,14 +299,61 @@ func TestDate_Sub_Panic(t *testing.T) {
 	assert := testifyrequire.New(t)
 
 	d1 := date.Date{Year: 1, Month: time.January, Day: 1}
-	d2 := date.Date{Year: 1_000_000, Month: time.January, Day: 1}
+	d2 := date.Date{Year: 1 << 60, Month: time.January, Day: 1}
 
 	assert.Panics(func() { d1.Sub(d2) })
 
 	days, err := d1.SubErr(d2)
 	assert.Equal(int64(0), days)
-	assert.NotNil(err)
-	assert.Equal("duration is not a whole number of days; duration=-2562047h47m16.854775808s", fmt.Sprintf("%v", err))
+	assert.True(errors.Is(err, date.ErrSubOutOfRange))
+	assert.Equal("date out of range for subtraction; date=date.NewDate(1, time.January, 1), other=date.NewDate(1152921504606846976, time.January, 1)", fmt.Sprintf("%v", err))
+}
+
+func TestDate_Sub_FarApart(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date     date.Date
+		Other    date.Date
+		Expected int64
+	}
+
+	cases := []testCase{
+		{
+			Date:     date.NewDate(9999, time.December, 31),
+			Other:    date.NewDate(1, time.January, 1),
+			Expected: 3_652_058,
+		},
+		{
+			Date:     date.NewDate(1, time.January, 1),
+			Other:    date.NewDate(1_000_000, time.January, 1),
+			Expected: -365_242_134,
+		},
+		{
+			Date:     date.NewDate(-4713, time.November, 24),
+			Other:    date.NewDate(1, time.January, 1),
+			Expected: -1_721_426,
+		},
+		{
+			Date:     date.NewDate(1_000_000_000_000_000, time.December, 31),
+			Other:    date.NewDate(-1_000_000_000_000_000, time.January, 1),
+			Expected: 730_485_000_000_000_365,
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s - %s", tc.Date.GoString(), tc.Other.GoString())
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			assert.Equal(tc.Expected, tc.Date.Sub(tc.Other))
+			assert.Equal(-tc.Expected, tc.Other.Sub(tc.Date))
+		})
+	}
 }
 
 func TestDate_MonthStart(base *testing.T) {
@@ -372,6 +420,94 @@ func TestDate_MonthEnd(base *testing.T) {
 	}
 }
 
+func TestDate_QuarterHalfYear(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date         string
+		Quarter      int
+		QuarterStart string
+		QuarterEnd   string
+		HalfStart    string
+		HalfEnd      string
+		YearStart    string
+		YearEnd      string
+	}
+
+	cases := []testCase{
+		{
+			Date:         "2024-01-01",
+			Quarter:      1,
+			QuarterStart: "2024-01-01",
+			QuarterEnd:   "2024-03-31",
+			HalfStart:    "2024-01-01",
+			HalfEnd:      "2024-06-30",
+			YearStart:    "2024-01-01",
+			YearEnd:      "2024-12-31",
+		},
+		{
+			Date:         "2024-05-15",
+			Quarter:      2,
+			QuarterStart: "2024-04-01",
+			QuarterEnd:   "2024-06-30",
+			HalfStart:    "2024-01-01",
+			HalfEnd:      "2024-06-30",
+			YearStart:    "2024-01-01",
+			YearEnd:      "2024-12-31",
+		},
+		{
+			Date:         "2023-09-30",
+			Quarter:      3,
+			QuarterStart: "2023-07-01",
+			QuarterEnd:   "2023-09-30",
+			HalfStart:    "2023-07-01",
+			HalfEnd:      "2023-12-31",
+			YearStart:    "2023-01-01",
+			YearEnd:      "2023-12-31",
+		},
+		{
+			Date:         "2023-12-31",
+			Quarter:      4,
+			QuarterStart: "2023-10-01",
+			QuarterEnd:   "2023-12-31",
+			HalfStart:    "2023-07-01",
+			HalfEnd:      "2023-12-31",
+			YearStart:    "2023-01-01",
+			YearEnd:      "2023-12-31",
+		},
+		{
+			Date:         "infinity",
+			Quarter:      4,
+			QuarterStart: "infinity",
+			QuarterEnd:   "infinity",
+			HalfStart:    "infinity",
+			HalfEnd:      "infinity",
+			YearStart:    "infinity",
+			YearEnd:      "infinity",
+		},
+	}
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d, err := date.FromString(tc.Date)
+			assert.Nil(err)
+
+			assert.Equal(tc.Quarter, d.Quarter())
+			assert.Equal(tc.QuarterStart, d.QuarterStart().String())
+			assert.Equal(tc.QuarterEnd, d.QuarterEnd().String())
+			assert.Equal(tc.HalfStart, d.HalfStart().String())
+			assert.Equal(tc.HalfEnd, d.HalfEnd().String())
+			assert.Equal(tc.YearStart, d.YearStart().String())
+			assert.Equal(tc.YearEnd, d.YearEnd().String())
+		})
+	}
+}
+
 func TestDate_Before(t *testing.T) {
 	t.Parallel()
 	assert := testifyrequire.New(t)
@@ -715,6 +851,55 @@ func TestDate_Scan(t *testing.T) {
 	assert.Equal(expected, d)
 }
 
+func TestDate_Scan_Text(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input string
+		Date  date.Date
+		Error string
+	}
+
+	cases := []testCase{
+		{Input: "1991-04-26", Date: date.NewDate(1991, time.April, 26)},
+		{Input: "1991-04-26 00:00:00", Date: date.NewDate(1991, time.April, 26)},
+		{Input: "1991-04-26 00:00:00.000", Date: date.NewDate(1991, time.April, 26)},
+		{Input: "1991-04-26 00:00:00+00:00", Date: date.NewDate(1991, time.April, 26)},
+		{Input: "1991-04-26T00:00:00", Date: date.NewDate(1991, time.April, 26)},
+		{Input: "1991-04-26T00:00:00Z", Date: date.NewDate(1991, time.April, 26)},
+		{Input: "1991-04-26 10:30:00", Error: "timestamp contains more than just date information; 1991-04-26T10:30:00Z"},
+		{Input: "1991-04-26T00:00:00-05:00", Error: "timestamp contains more than just date information; 1991-04-26T00:00:00-05:00"},
+		{Input: "1991-02-30", Error: `parsing time "1991-02-30": day out of range`},
+		{Input: "04/26/1991", Error: `parsing time "04/26/1991" as "2006-01-02": cannot parse "04/26/1991" as "2006"`},
+		{Input: "1991-04-26 midnight", Error: `parsing time "1991-04-26 midnight" as "2006-01-02 15:04:05.999999999": cannot parse "midnight" as "15"`},
+		{Input: "1991-02-30 00:00:00", Error: `parsing time "1991-02-30 00:00:00": day out of range`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			for _, src := range []any{tc.Input, []byte(tc.Input)} {
+				d := date.Date{}
+				err := d.Scan(src)
+				if tc.Error != "" {
+					assert.NotNil(err)
+					assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+					assert.Equal(date.Date{}, d)
+					continue
+				}
+
+				assert.Nil(err)
+				assert.Equal(tc.Date, d)
+			}
+		})
+	}
+}
+
 func TestDate_Value(t *testing.T) {
 	t.Parallel()
 	assert := testifyrequire.New(t)
@@ -0,0 +1,291 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+	"time"
+)
+
+// DayCountConvention determines how the number of days (and the fraction of a
+// year) between two dates is computed when accruing interest.
+type DayCountConvention int
+
+const (
+	// DayCountThirty360US is the 30/360 US convention (also known as 30/360
+	// SIA), the "bond basis" used for US corporate and agency bonds. With `D1`
+	// and `D2` the day of the start and end dates:
+	// - if `D1` and `D2` are both the last day of February, `D2` becomes 30
+	// - if `D1` is the last day of February, `D1` becomes 30
+	// - if `D2` is 31 and `D1` is 30 or 31, `D2` becomes 30
+	// - if `D1` is 31, `D1` becomes 30
+	DayCountThirty360US DayCountConvention = iota + 1
+	// DayCountThirty360BondBasis is the 30/360 convention from the 2006 ISDA
+	// definitions (section 4.16(f)). It is the same as 30/360 US but without
+	// the rules for the last day of February:
+	// - if `D1` is 31, `D1` becomes 30
+	// - if `D2` is 31 and `D1` is 30 or 31, `D2` becomes 30
+	DayCountThirty360BondBasis
+	// DayCountThirtyE360 is the 30E/360 (Eurobond basis) convention from the
+	// 2006 ISDA definitions (section 4.16(g)):
+	// - if `D1` is 31, `D1` becomes 30
+	// - if `D2` is 31, `D2` becomes 30
+	DayCountThirtyE360
+	// DayCountThirtyE360ISDA is the 30E/360 (ISDA) convention from the 2006
+	// ISDA definitions (section 4.16(h)):
+	// - if `D1` is the last day of the month, `D1` becomes 30
+	// - if `D2` is the last day of February (but not the termination date) or
+	//   `D2` is 31, `D2` becomes 30
+	//
+	// The termination date can be provided via `OptDayCountTerminationDate()`.
+	DayCountThirtyE360ISDA
+	// DayCountActual360 is the ACT/360 convention: the actual number of days
+	// divided by 360.
+	DayCountActual360
+	// DayCountActual365Fixed is the ACT/365 Fixed convention: the actual number
+	// of days divided by 365, regardless of leap years.
+	DayCountActual365Fixed
+	// DayCountActualActualISDA is the ACT/ACT ISDA convention: the actual
+	// number of days falling in a leap year divided by 366 plus the actual
+	// number of days falling in a non-leap year divided by 365.
+	DayCountActualActualISDA
+	// DayCountActualActualICMA is the ACT/ACT ICMA convention: the actual
+	// number of days divided by the product of the number of days in the
+	// reference (coupon) period and the number of coupons per year. The
+	// reference period and frequency **must** be provided via
+	// `OptDayCountReferencePeriod()` and `OptDayCountFrequency()`.
+	DayCountActualActualICMA
+)
+
+// String implements `fmt.Stringer`.
+func (dcc DayCountConvention) String() string {
+	switch dcc {
+	case DayCountThirty360US:
+		return "30/360 US"
+	case DayCountThirty360BondBasis:
+		return "30/360 Bond Basis"
+	case DayCountThirtyE360:
+		return "30E/360"
+	case DayCountThirtyE360ISDA:
+		return "30E/360 ISDA"
+	case DayCountActual360:
+		return "ACT/360"
+	case DayCountActual365Fixed:
+		return "ACT/365F"
+	case DayCountActualActualISDA:
+		return "ACT/ACT ISDA"
+	case DayCountActualActualICMA:
+		return "ACT/ACT ICMA"
+	default:
+		return fmt.Sprintf("DayCountConvention(%d)", int(dcc))
+	}
+}
+
+// DayCountConfig helps customize the behavior of day count methods like
+// `DayCountConvention{}.YearFraction()`.
+type DayCountConfig struct {
+	TerminationDate NullDate
+	ReferencePeriod DateRange
+	Frequency       int
+}
+
+// DayCountOption defines a function that will be applied to a day count
+// config.
+type DayCountOption func(*DayCountConfig)
+
+// OptDayCountTerminationDate returns an option that sets the termination
+// (i.e. maturity) date on a day count config. This is used by 30E/360 ISDA.
+func OptDayCountTerminationDate(d Date) DayCountOption {
+	return func(dc *DayCountConfig) {
+		dc.TerminationDate = NullDate{Date: d, Valid: true}
+	}
+}
+
+// OptDayCountReferencePeriod returns an option that sets the reference
+// (i.e. regular coupon) period `[start, end)` on a day count config. This is
+// used by ACT/ACT ICMA.
+func OptDayCountReferencePeriod(start, end Date) DayCountOption {
+	return func(dc *DayCountConfig) {
+		dc.ReferencePeriod = NewDateRange(start, end)
+	}
+}
+
+// OptDayCountFrequency returns an option that sets the number of coupons per
+// year on a day count config. This is used by ACT/ACT ICMA.
+func OptDayCountFrequency(frequency int) DayCountOption {
+	return func(dc *DayCountConfig) {
+		dc.Frequency = frequency
+	}
+}
+
+// DayCount returns the number of days between `start` and `end` according to
+// the convention. For the 30/360 family this is the number of days in a
+// notional calendar of twelve 30-day months; for the ACT family this is the
+// actual number of days, i.e. `end.Sub(start)`.
+//
+// If `end` is before `start`, the result is the negative of the day count
+// from `end` to `start`. If either date is infinite, an error wrapping
+// `ErrSubInfinite` is returned.
+func (dcc DayCountConvention) DayCount(start, end Date, opts ...DayCountOption) (int64, error) {
+	if start.IsInfinite() || end.IsInfinite() {
+		return 0, fmt.Errorf("%w; start=%s, end=%s", ErrSubInfinite, start, end)
+	}
+
+	if end.Before(start) {
+		days, err := dcc.DayCount(end, start, opts...)
+		return -days, err
+	}
+
+	dc := DayCountConfig{}
+	for _, opt := range opts {
+		opt(&dc)
+	}
+
+	switch dcc {
+	case DayCountThirty360US, DayCountThirty360BondBasis, DayCountThirtyE360, DayCountThirtyE360ISDA:
+		return dcc.thirty360Days(start, end, dc), nil
+	case DayCountActual360, DayCountActual365Fixed, DayCountActualActualISDA, DayCountActualActualICMA:
+		return end.Sub(start), nil
+	default:
+		return 0, fmt.Errorf("unsupported day count convention; %s", dcc)
+	}
+}
+
+// YearFraction returns the fraction of a year between `start` and `end`
+// according to the convention; this is the factor applied to an annual
+// interest rate when accruing interest.
+//
+// If `end` is before `start`, the result is the negative of the year fraction
+// from `end` to `start`. If either date is infinite, an error wrapping
+// `ErrSubInfinite` is returned.
+func (dcc DayCountConvention) YearFraction(start, end Date, opts ...DayCountOption) (float64, error) {
+	if start.IsInfinite() || end.IsInfinite() {
+		return 0, fmt.Errorf("%w; start=%s, end=%s", ErrSubInfinite, start, end)
+	}
+
+	if end.Before(start) {
+		fraction, err := dcc.YearFraction(end, start, opts...)
+		return -fraction, err
+	}
+
+	dc := DayCountConfig{}
+	for _, opt := range opts {
+		opt(&dc)
+	}
+
+	days, err := dcc.DayCount(start, end, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	switch dcc {
+	case DayCountThirty360US, DayCountThirty360BondBasis, DayCountThirtyE360, DayCountThirtyE360ISDA, DayCountActual360:
+		return float64(days) / 360, nil
+	case DayCountActual365Fixed:
+		return float64(days) / 365, nil
+	case DayCountActualActualISDA:
+		return actualActualISDA(start, end), nil
+	case DayCountActualActualICMA:
+		if dc.ReferencePeriod.IsEmpty() {
+			return 0, fmt.Errorf("day count convention requires a reference period; %s", dcc)
+		}
+		if dc.ReferencePeriod.Start.IsInfinite() || dc.ReferencePeriod.End.IsInfinite() {
+			return 0, fmt.Errorf("day count convention requires a finite reference period; %s, reference=%s", dcc, dc.ReferencePeriod)
+		}
+		if dc.Frequency <= 0 {
+			return 0, fmt.Errorf("day count convention requires a positive frequency; %s, frequency=%d", dcc, dc.Frequency)
+		}
+		referenceDays := dc.ReferencePeriod.Days()
+		return float64(days) / float64(int64(dc.Frequency)*referenceDays), nil
+	default:
+		return 0, fmt.Errorf("unsupported day count convention; %s", dcc)
+	}
+}
+
+// thirty360Days computes `360 * (Y2 - Y1) + 30 * (M2 - M1) + (D2 - D1)` after
+// applying the day adjustments specific to each 30/360 convention.
+func (dcc DayCountConvention) thirty360Days(start, end Date, dc DayCountConfig) int64 {
+	d1 := start.Day
+	d2 := end.Day
+
+	switch dcc {
+	case DayCountThirty360US:
+		if isLastDayOfFebruary(start) && isLastDayOfFebruary(end) {
+			d2 = 30
+		}
+		if isLastDayOfFebruary(start) {
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	case DayCountThirty360BondBasis:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+	case DayCountThirtyE360:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 {
+			d2 = 30
+		}
+	case DayCountThirtyE360ISDA:
+		if start.Equal(start.MonthEnd()) {
+			d1 = 30
+		}
+		isTermination := dc.TerminationDate.Valid && dc.TerminationDate.Date.Equal(end)
+		if (isLastDayOfFebruary(end) && !isTermination) || d2 == 31 {
+			d2 = 30
+		}
+	}
+
+	years := int64(end.Year - start.Year)
+	months := int64(end.Month - start.Month)
+	return 360*years + 30*months + int64(d2-d1)
+}
+
+func isLastDayOfFebruary(d Date) bool {
+	return d.Month == time.February && d.Day == daysIn(time.February, d.Year)
+}
+
+func daysInYear(year int) int64 {
+	if isLeap(year) {
+		return 366
+	}
+	return 365
+}
+
+// actualActualISDA splits the range `[start, end)` at each year boundary and
+// divides the days in each year by the length of that year.
+func actualActualISDA(start, end Date) float64 {
+	if start.Year == end.Year {
+		return float64(end.Sub(start)) / float64(daysInYear(start.Year))
+	}
+
+	startYearEnd := Date{Year: start.Year + 1, Month: time.January, Day: 1}
+	endYearStart := Date{Year: end.Year, Month: time.January, Day: 1}
+
+	fraction := float64(startYearEnd.Sub(start)) / float64(daysInYear(start.Year))
+	fraction += float64(end.Year - start.Year - 1)
+	fraction += float64(end.Sub(endYearStart)) / float64(daysInYear(end.Year))
+	return fraction
+}
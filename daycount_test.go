@@ -0,0 +1,218 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestDayCountConvention_DayCount(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Convention  date.DayCountConvention
+		Start       string
+		End         string
+		Termination string
+		Expected    int64
+	}
+
+	cases := []testCase{
+		// 30/360 US
+		{Convention: date.DayCountThirty360US, Start: "2024-01-15", End: "2024-07-15", Expected: 180},
+		{Convention: date.DayCountThirty360US, Start: "2024-01-31", End: "2024-02-29", Expected: 29},
+		{Convention: date.DayCountThirty360US, Start: "2024-02-29", End: "2024-03-31", Expected: 30},
+		{Convention: date.DayCountThirty360US, Start: "2023-02-28", End: "2024-02-29", Expected: 360},
+		{Convention: date.DayCountThirty360US, Start: "2024-02-28", End: "2024-03-31", Expected: 33},
+		{Convention: date.DayCountThirty360US, Start: "2024-03-31", End: "2024-04-30", Expected: 30},
+		{Convention: date.DayCountThirty360US, Start: "2024-07-15", End: "2024-01-15", Expected: -180},
+		// 30/360 Bond Basis
+		{Convention: date.DayCountThirty360BondBasis, Start: "2024-02-29", End: "2024-03-31", Expected: 32},
+		{Convention: date.DayCountThirty360BondBasis, Start: "2023-02-28", End: "2024-02-29", Expected: 361},
+		{Convention: date.DayCountThirty360BondBasis, Start: "2024-03-30", End: "2024-03-31", Expected: 0},
+		{Convention: date.DayCountThirty360BondBasis, Start: "2024-03-29", End: "2024-03-31", Expected: 2},
+		// 30E/360
+		{Convention: date.DayCountThirtyE360, Start: "2024-02-29", End: "2024-03-31", Expected: 31},
+		{Convention: date.DayCountThirtyE360, Start: "2024-01-31", End: "2024-02-29", Expected: 29},
+		{Convention: date.DayCountThirtyE360, Start: "2024-03-29", End: "2024-03-31", Expected: 1},
+		// 30E/360 ISDA
+		{Convention: date.DayCountThirtyE360ISDA, Start: "2024-02-29", End: "2024-03-31", Expected: 30},
+		{Convention: date.DayCountThirtyE360ISDA, Start: "2024-01-31", End: "2024-02-29", Expected: 30},
+		{Convention: date.DayCountThirtyE360ISDA, Start: "2024-01-31", End: "2024-02-29", Termination: "2024-02-29", Expected: 29},
+		{Convention: date.DayCountThirtyE360ISDA, Start: "2023-02-28", End: "2023-08-31", Expected: 180},
+		{Convention: date.DayCountThirtyE360ISDA, Start: "2024-02-28", End: "2024-08-31", Expected: 182},
+		// ACT/*
+		{Convention: date.DayCountActual360, Start: "2024-01-01", End: "2024-07-01", Expected: 182},
+		{Convention: date.DayCountActual365Fixed, Start: "2024-02-29", End: "2024-03-31", Expected: 31},
+		{Convention: date.DayCountActualActualISDA, Start: "2003-11-01", End: "2004-05-01", Expected: 182},
+		{Convention: date.DayCountActualActualICMA, Start: "2004-05-01", End: "2003-11-01", Expected: -182},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s:%s-%s", tc.Convention, tc.Start, tc.End)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			start := mustDate(assert, tc.Start)
+			end := mustDate(assert, tc.End)
+			opts := []date.DayCountOption{}
+			if tc.Termination != "" {
+				opts = append(opts, date.OptDayCountTerminationDate(mustDate(assert, tc.Termination)))
+			}
+
+			days, err := tc.Convention.DayCount(start, end, opts...)
+			assert.Nil(err)
+			assert.Equal(tc.Expected, days)
+		})
+	}
+}
+
+func TestDayCountConvention_YearFraction(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Convention date.DayCountConvention
+		Start      string
+		End        string
+		Options    []date.DayCountOption
+		Expected   float64
+	}
+
+	cases := []testCase{
+		{Convention: date.DayCountThirty360US, Start: "2024-01-15", End: "2024-07-15", Expected: 0.5},
+		{Convention: date.DayCountThirty360US, Start: "2023-02-28", End: "2024-02-29", Expected: 1},
+		{Convention: date.DayCountThirtyE360, Start: "2024-02-29", End: "2024-03-31", Expected: 31.0 / 360},
+		{Convention: date.DayCountActual360, Start: "2024-01-01", End: "2024-07-01", Expected: 182.0 / 360},
+		{Convention: date.DayCountActual365Fixed, Start: "2024-01-01", End: "2025-01-01", Expected: 366.0 / 365},
+		{Convention: date.DayCountActualActualISDA, Start: "2024-01-01", End: "2025-01-01", Expected: 1},
+		{Convention: date.DayCountActualActualISDA, Start: "2024-02-01", End: "2024-03-01", Expected: 29.0 / 366},
+		{Convention: date.DayCountActualActualISDA, Start: "2003-11-01", End: "2004-05-01", Expected: 61.0/365 + 121.0/366},
+		{Convention: date.DayCountActualActualISDA, Start: "2003-11-01", End: "2006-05-01", Expected: 61.0/365 + 2 + 120.0/365},
+		{Convention: date.DayCountActualActualISDA, Start: "2004-05-01", End: "2003-11-01", Expected: -(61.0/365 + 121.0/366)},
+		{
+			Convention: date.DayCountActualActualICMA,
+			Start:      "2003-11-01",
+			End:        "2004-05-01",
+			Options: []date.DayCountOption{
+				date.OptDayCountReferencePeriod(date.NewDate(2003, 11, 1), date.NewDate(2004, 5, 1)),
+				date.OptDayCountFrequency(2),
+			},
+			Expected: 0.5,
+		},
+		{
+			Convention: date.DayCountActualActualICMA,
+			Start:      "1999-02-01",
+			End:        "1999-07-15",
+			Options: []date.DayCountOption{
+				date.OptDayCountReferencePeriod(date.NewDate(1999, 1, 15), date.NewDate(1999, 7, 15)),
+				date.OptDayCountFrequency(2),
+			},
+			Expected: 164.0 / (2 * 181),
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s:%s-%s", tc.Convention, tc.Start, tc.End)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			start := mustDate(assert, tc.Start)
+			end := mustDate(assert, tc.End)
+
+			fraction, err := tc.Convention.YearFraction(start, end, tc.Options...)
+			assert.Nil(err)
+			assert.InDelta(tc.Expected, fraction, 1e-12)
+		})
+	}
+}
+
+func TestDayCountConvention_Errors(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	start := mustDate(assert, "2024-01-01")
+	end := mustDate(assert, "2024-07-01")
+
+	// Unknown convention
+	unknown := date.DayCountConvention(0)
+	days, err := unknown.DayCount(start, end)
+	assert.Equal(int64(0), days)
+	assert.NotNil(err)
+	assert.Equal("unsupported day count convention; DayCountConvention(0)", fmt.Sprintf("%v", err))
+	fraction, err := unknown.YearFraction(start, end)
+	assert.Equal(0.0, fraction)
+	assert.NotNil(err)
+	assert.Equal("unsupported day count convention; DayCountConvention(0)", fmt.Sprintf("%v", err))
+
+	// ACT/ACT ICMA without a reference period
+	fraction, err = date.DayCountActualActualICMA.YearFraction(start, end, date.OptDayCountFrequency(2))
+	assert.Equal(0.0, fraction)
+	assert.NotNil(err)
+	assert.Equal("day count convention requires a reference period; ACT/ACT ICMA", fmt.Sprintf("%v", err))
+
+	// ACT/ACT ICMA without a frequency
+	fraction, err = date.DayCountActualActualICMA.YearFraction(start, end, date.OptDayCountReferencePeriod(start, end))
+	assert.Equal(0.0, fraction)
+	assert.NotNil(err)
+	assert.Equal("day count convention requires a positive frequency; ACT/ACT ICMA, frequency=0", fmt.Sprintf("%v", err))
+
+	// ACT/ACT ICMA with an infinite reference period
+	fraction, err = date.DayCountActualActualICMA.YearFraction(start, end, date.OptDayCountFrequency(2), date.OptDayCountReferencePeriod(start, date.Infinity))
+	assert.Equal(0.0, fraction)
+	assert.NotNil(err)
+	assert.Equal("day count convention requires a finite reference period; ACT/ACT ICMA, reference=[2024-01-01,infinity)", fmt.Sprintf("%v", err))
+
+	// Infinite dates
+	days, err = date.DayCountActual360.DayCount(start, date.Infinity)
+	assert.Equal(int64(0), days)
+	assert.True(errors.Is(err, date.ErrSubInfinite))
+	assert.Equal("cannot subtract infinite dates; start=2024-01-01, end=infinity", fmt.Sprintf("%v", err))
+	days, err = date.DayCountThirty360US.DayCount(date.NegativeInfinity, end)
+	assert.Equal(int64(0), days)
+	assert.True(errors.Is(err, date.ErrSubInfinite))
+	fraction, err = date.DayCountActualActualISDA.YearFraction(date.Infinity, end)
+	assert.Equal(0.0, fraction)
+	assert.True(errors.Is(err, date.ErrSubInfinite))
+	assert.Equal("cannot subtract infinite dates; start=infinity, end=2024-07-01", fmt.Sprintf("%v", err))
+}
+
+func TestDayCountConvention_String(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	assert.Equal("30/360 US", date.DayCountThirty360US.String())
+	assert.Equal("30/360 Bond Basis", date.DayCountThirty360BondBasis.String())
+	assert.Equal("30E/360", date.DayCountThirtyE360.String())
+	assert.Equal("30E/360 ISDA", date.DayCountThirtyE360ISDA.String())
+	assert.Equal("ACT/360", date.DayCountActual360.String())
+	assert.Equal("ACT/365F", date.DayCountActual365Fixed.String())
+	assert.Equal("ACT/ACT ISDA", date.DayCountActualActualISDA.String())
+	assert.Equal("ACT/ACT ICMA", date.DayCountActualActualICMA.String())
+	assert.Equal("DayCountConvention(99)", date.DayCountConvention(99).String())
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// NOTE: Ensure that
+// - `*TextDate` satisfies `sql.Scanner`.
+// - `TextDate` satisfies `driver.Valuer`.
+// - `*NullTextDate` satisfies `sql.Scanner`.
+// - `NullTextDate` satisfies `driver.Valuer`.
+var (
+	_ sql.Scanner   = (*TextDate)(nil)
+	_ driver.Valuer = TextDate{}
+	_ sql.Scanner   = (*NullTextDate)(nil)
+	_ driver.Valuer = NullTextDate{}
+)
+
+// TextDate is a `Date{}` that is serialized into the database as a
+// YYYY-MM-DD string rather than a `time.Time{}`. This is intended for columns
+// that store dates as TEXT (e.g. in SQLite).
+type TextDate struct {
+	Date
+}
+
+// Scan implements `sql.Scanner`; it unmarshals values of the type `time.Time`,
+// `string` or `[]byte` onto the current `TextDate` struct.
+func (td *TextDate) Scan(src any) error {
+	return td.Date.Scan(src)
+}
+
+// Value implements `driver.Valuer`; it marshals the value to a YYYY-MM-DD
+// string to be serialized into the database.
+func (td TextDate) Value() (driver.Value, error) {
+	return td.Date.String(), nil
+}
+
+// NullTextDate is a `NullDate{}` that is serialized into the database as a
+// YYYY-MM-DD string (or `nil`) rather than a `time.Time{}`.
+type NullTextDate struct {
+	NullDate
+}
+
+// Scan implements `sql.Scanner`; it unmarshals nullable values of the type
+// `time.Time`, `string` or `[]byte` onto the current `NullTextDate` struct.
+func (ntd *NullTextDate) Scan(value any) error {
+	return ntd.NullDate.Scan(value)
+}
+
+// Value implements `driver.Valuer`; it marshals the value to a YYYY-MM-DD
+// string (or `nil`) to be serialized into the database.
+func (ntd NullTextDate) Value() (driver.Value, error) {
+	if !ntd.Valid {
+		return nil, nil
+	}
+
+	return TextDate{Date: ntd.Date}.Value()
+}
+
+// databaseLayouts are the datetime layouts accepted by `Date{}.Scan()` in
+// addition to YYYY-MM-DD; e.g. MySQL (without `parseTime=true`) and SQLite may
+// provide a DATE column as a datetime with an all-zero time portion.
+var databaseLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+}
+
+// fromDatabaseString parses a date provided as text by a database driver. The
+// value must be of the form YYYY-MM-DD or a datetime with an all-zero time
+// portion (e.g. 2024-01-01 00:00:00). If a datetime cannot be parsed, the
+// `*ParseError` is from the layout that matched the most of the value.
+func fromDatabaseString(s string) (Date, error) {
+	if len(s) <= len(time.DateOnly) {
+		return FromString(s)
+	}
+
+	var best *ParseError
+	for _, layout := range databaseLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return FromTime(t)
+		}
+
+		pe := newParseError(s, layout, err)
+		if best == nil || betterParseError(pe, best) {
+			best = pe
+		}
+	}
+
+	return Date{}, best
+}
+
+// betterParseError returns true if `pe` matched more of its input than
+// `other`. A range error (e.g. 2024-02-30) means the entire value matched the
+// layout, so it is always preferred.
+func betterParseError(pe, other *ParseError) bool {
+	invalid, otherInvalid := pe.Is(ErrInvalidDate), other.Is(ErrInvalidDate)
+	if invalid != otherInvalid {
+		return invalid
+	}
+
+	return pe.Offset > other.Offset
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestTextDate_Value(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(1991, time.April, 26)
+	v, err := date.TextDate{Date: d}.Value()
+	assert.Nil(err)
+	assert.Equal("1991-04-26", v)
+
+	v, err = date.TextDate{Date: date.Infinity}.Value()
+	assert.Nil(err)
+	assert.Equal("infinity", v)
+
+	// Round trip
+	v, err = date.TextDate{Date: d}.Value()
+	assert.Nil(err)
+	scanned := date.TextDate{}
+	err = scanned.Scan(v)
+	assert.Nil(err)
+	assert.Equal(date.TextDate{Date: d}, scanned)
+
+	// `Date{}` is unchanged
+	v, err = d.Value()
+	assert.Nil(err)
+	assert.Equal(d.ToTime(), v)
+}
+
+func TestNullTextDate_Value(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(1991, time.April, 26)
+	v, err := date.NullTextDate{NullDate: date.NullDate{Date: d, Valid: true}}.Value()
+	assert.Nil(err)
+	assert.Equal("1991-04-26", v)
+
+	v, err = date.NullTextDate{}.Value()
+	assert.Nil(err)
+	assert.Nil(v)
+
+	scanned := date.NullTextDate{}
+	err = scanned.Scan("1991-04-26")
+	assert.Nil(err)
+	assert.Equal(date.NullTextDate{NullDate: date.NullDate{Date: d, Valid: true}}, scanned)
+
+	err = scanned.Scan(nil)
+	assert.Nil(err)
+	assert.Equal(date.NullTextDate{}, scanned)
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// daysPer400Years is the number of days in a 400 year cycle of the
+	// (proleptic) Gregorian calendar.
+	daysPer400Years = 146_097
+	// unixEpochShift is the number of days from 0000-03-01 (the start of the
+	// computational era used below) to 1970-01-01.
+	unixEpochShift = 719_468
+	// maxSubComponent is the largest magnitude of a year, month or day for
+	// which the difference between two dates (in days) is guaranteed to fit
+	// in an `int64`.
+	maxSubComponent = 1_000_000_000_000_000
+)
+
+// EpochDays returns the number of days since the Unix epoch (1970-01-01);
+// dates before the epoch are negative. This is computed with pure integer
+// arithmetic (i.e. without converting to a `time.Time{}`) using the
+// proleptic Gregorian calendar.
+//
+// As with `time.Date()`, a day or month outside of the usual range is
+// normalized, e.g. 2024-02-30 is treated as 2024-03-01. `Infinity` and
+// `NegativeInfinity` return `math.MaxInt64` and `math.MinInt64`.
+//
+// See http://howardhinnant.github.io/date_algorithms.html#days_from_civil
+func (d Date) EpochDays() int64 {
+	switch d {
+	case Infinity:
+		return math.MaxInt64
+	case NegativeInfinity:
+		return math.MinInt64
+	}
+
+	// NOTE: Normalize the month into [1, 12] (adjusting the year) so that the
+	//       algorithm below can assume a valid month.
+	monthIndex := int64(d.Month) - 1
+	year := int64(d.Year) + floorDiv(monthIndex, 12)
+	month := monthIndex - 12*floorDiv(monthIndex, 12) + 1
+
+	// NOTE: Shift the start of the year to March 1, so that the leap day is
+	//       the last day of the (shifted) year.
+	if month <= 2 {
+		year--
+	}
+	era := floorDiv(year, 400)
+	yearOfEra := year - 400*era
+	shiftedMonth := (month + 9) % 12
+	dayOfYear := (153*shiftedMonth+2)/5 + int64(d.Day) - 1
+	dayOfEra := 365*yearOfEra + yearOfEra/4 - yearOfEra/100 + dayOfYear
+	return daysPer400Years*era + dayOfEra - unixEpochShift
+}
+
+// FromEpochDays returns the date that is `days` days after the Unix epoch
+// (1970-01-01); this is the inverse of `EpochDays()`. This is computed with
+// pure integer arithmetic (i.e. without converting to a `time.Time{}`) using
+// the proleptic Gregorian calendar.
+//
+// See http://howardhinnant.github.io/date_algorithms.html#civil_from_days
+func FromEpochDays(days int64) Date {
+	switch days {
+	case math.MaxInt64:
+		return Infinity
+	case math.MinInt64:
+		return NegativeInfinity
+	}
+
+	shifted := days + unixEpochShift
+	era := floorDiv(shifted, daysPer400Years)
+	dayOfEra := shifted - daysPer400Years*era
+	yearOfEra := (dayOfEra - dayOfEra/1460 + dayOfEra/36524 - dayOfEra/146096) / 365
+	dayOfYear := dayOfEra - (365*yearOfEra + yearOfEra/4 - yearOfEra/100)
+	shiftedMonth := (5*dayOfYear + 2) / 153
+	day := dayOfYear - (153*shiftedMonth+2)/5 + 1
+
+	month := shiftedMonth + 3
+	if shiftedMonth >= 10 {
+		month = shiftedMonth - 9
+	}
+	year := yearOfEra + 400*era
+	if month <= 2 {
+		year++
+	}
+
+	return Date{Year: int(year), Month: time.Month(month), Day: int(day)}
+}
+
+// inSubRange returns true if the year, month and day of `d` are small enough
+// in magnitude that `EpochDays()` differences cannot overflow.
+func inSubRange(d Date) bool {
+	return inRange(int64(d.Year)) && inRange(int64(d.Month)) && inRange(int64(d.Day))
+}
+
+func inRange(value int64) bool {
+	return -maxSubComponent <= value && value <= maxSubComponent
+}
+
+// floorDiv returns `a / b` rounded toward negative infinity (rather than
+// toward zero); `b` must be positive.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestDate_EpochDays(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date     date.Date
+		Expected int64
+	}
+
+	cases := []testCase{
+		{Date: date.NewDate(1970, time.January, 1), Expected: 0},
+		{Date: date.NewDate(1969, time.December, 31), Expected: -1},
+		{Date: date.NewDate(2000, time.January, 1), Expected: 10_957},
+		{Date: date.NewDate(2000, time.February, 29), Expected: 11_016},
+		{Date: date.NewDate(2024, time.February, 29), Expected: 19_782},
+		{Date: date.NewDate(1, time.January, 1), Expected: -719_162},
+		{Date: date.NewDate(0, time.March, 1), Expected: -719_468},
+		{Date: date.NewDate(-1, time.December, 31), Expected: -719_529},
+		{Date: date.NewDate(9999, time.December, 31), Expected: 2_932_896},
+		{Date: date.NewDate(2024, time.February, 30), Expected: 19_783},
+		{Date: date.NewDate(2024, time.Month(13), 1), Expected: 20_089},
+		{Date: date.NewDate(2024, time.Month(0), 1), Expected: 19_692},
+		{Date: date.NewDate(2024, time.March, 0), Expected: 19_782},
+		{Date: date.Infinity, Expected: math.MaxInt64},
+		{Date: date.NegativeInfinity, Expected: math.MinInt64},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date.GoString(), func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			assert.Equal(tc.Expected, tc.Date.EpochDays())
+		})
+	}
+}
+
+func TestFromEpochDays(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Days     int64
+		Expected string
+	}
+
+	cases := []testCase{
+		{Days: 0, Expected: "1970-01-01"},
+		{Days: -1, Expected: "1969-12-31"},
+		{Days: 10_957, Expected: "2000-01-01"},
+		{Days: 11_016, Expected: "2000-02-29"},
+		{Days: 19_782, Expected: "2024-02-29"},
+		{Days: -719_162, Expected: "0001-01-01"},
+		{Days: 2_932_896, Expected: "9999-12-31"},
+		{Days: math.MaxInt64, Expected: "infinity"},
+		{Days: math.MinInt64, Expected: "-infinity"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(fmt.Sprintf("%d", tc.Days), func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := date.FromEpochDays(tc.Days)
+			assert.Equal(tc.Expected, d.String())
+			assert.Equal(tc.Days, d.EpochDays())
+		})
+	}
+}
+
+func TestEpochDays_MatchesTime(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	// NOTE: Check every day from 1600-01-01 through 2400-12-31 (which covers
+	//       several 400 year cycles) against the `time` package.
+	start := time.Date(1600, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2400, time.December, 31, 0, 0, 0, 0, time.UTC)
+	for current := start; !current.After(end); current = current.AddDate(0, 0, 1) {
+		assertMatchesTime(assert, current)
+	}
+
+	// NOTE: Check a sample of days across (roughly) years -100,000 through
+	//       100,000 against the `time` package.
+	r := rand.New(rand.NewSource(20240229))
+	for i := 0; i < 20_000; i++ {
+		days := r.Int63n(73_000_000) - 36_500_000
+		assertMatchesTime(assert, time.Unix(days*86_400, 0).UTC())
+	}
+}
+
+func TestEpochDays_AddDaysMatchesTime(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	r := rand.New(rand.NewSource(20240101))
+	for i := 0; i < 20_000; i++ {
+		d := date.FromEpochDays(r.Int63n(1_000_000) - 500_000)
+		// NOTE: Include days outside of the usual range, which are normalized.
+		d.Day = r.Intn(70) - 20
+		days := r.Intn(100_000) - 50_000
+
+		expected := d.ToTime().AddDate(0, 0, days)
+		actual := d.AddDays(days)
+		assert.Equal(date.NewDate(expected.Year(), expected.Month(), expected.Day()), actual)
+		assert.Equal(d.ToTime().Weekday(), d.Weekday())
+		assert.Equal(d.ToTime().YearDay(), d.YearDay())
+	}
+}
+
+func assertMatchesTime(assert *testifyrequire.Assertions, t time.Time) {
+	days := int64(math.Floor(float64(t.Unix()) / 86_400))
+	d := date.NewDate(t.Year(), t.Month(), t.Day())
+
+	assert.Equal(days, d.EpochDays())
+	assert.Equal(d, date.FromEpochDays(days))
+	assert.Equal(t.Weekday(), d.Weekday())
+	assert.Equal(t.YearDay(), d.YearDay())
+}
+
+func BenchmarkDate_AddDays(b *testing.B) {
+	d := date.NewDate(2024, time.February, 29)
+	for i := 0; i < b.N; i++ {
+		_ = d.AddDays(i % 1000)
+	}
+}
+
+func BenchmarkDate_AddDays_Time(b *testing.B) {
+	d := date.NewDate(2024, time.February, 29)
+	for i := 0; i < b.N; i++ {
+		t := d.ToTime().AddDate(0, 0, i%1000)
+		_ = date.NewDate(t.Year(), t.Month(), t.Day())
+	}
+}
+
+func BenchmarkDate_Weekday(b *testing.B) {
+	d := date.NewDate(2024, time.February, 29)
+	for i := 0; i < b.N; i++ {
+		_ = d.Weekday()
+	}
+}
+
+func BenchmarkDate_Weekday_Time(b *testing.B) {
+	d := date.NewDate(2024, time.February, 29)
+	for i := 0; i < b.N; i++ {
+		_ = d.ToTime().Weekday()
+	}
+}
+
+func BenchmarkDate_YearDay(b *testing.B) {
+	d := date.NewDate(2024, time.February, 29)
+	for i := 0; i < b.N; i++ {
+		_ = d.YearDay()
+	}
+}
+
+func BenchmarkDate_YearDay_Time(b *testing.B) {
+	d := date.NewDate(2024, time.February, 29)
+	for i := 0; i < b.N; i++ {
+		_ = d.ToTime().YearDay()
+	}
+}
+
+func BenchmarkFromEpochDays(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = date.FromEpochDays(int64(i % 3_000_000))
+	}
+}
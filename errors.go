@@ -0,0 +1,145 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// NOTE: Ensure that `*ParseError` satisfies `error`.
+var (
+	_ error = (*ParseError)(nil)
+)
+
+var (
+	// ErrNotDateOnly is returned (wrapped) when a timestamp has a non-zero time
+	// of day or a non-UTC offset, e.g. by `FromTime()` or `Scan()`.
+	ErrNotDateOnly = errors.New("timestamp contains more than just date information")
+	// ErrInvalidDate is returned (wrapped) when the month or day of a date is
+	// out of range, e.g. 2024-02-30. A `*ParseError` for an out of range month
+	// or day also matches `ErrInvalidDate` via `errors.Is()`.
+	ErrInvalidDate = errors.New("invalid date")
+	// ErrInvalidLayout is returned (wrapped) by `Parse()` when the layout
+	// contains a time of day or time zone element (e.g. `15:04` or `MST`) or
+	// does not contain any date elements (e.g. `foo`).
+	ErrInvalidLayout = errors.New("invalid date-only layout")
+	// ErrUnrecognizedDate is returned (wrapped) by a `Parser` when a value does
+	// not match any of its layouts.
+	ErrUnrecognizedDate = errors.New("date does not match any layout")
+	// ErrAmbiguousDate is returned (wrapped) by a `Parser` that rejects
+	// ambiguous inputs when two layouts parse a value as different dates, e.g.
+	// `03/04/2024` as either March 4 or April 3.
+	ErrAmbiguousDate = errors.New("ambiguous date")
+	// ErrSubInfinite is returned (wrapped) by `SubErr()` when either date is
+	// `Infinity` or `NegativeInfinity`.
+	ErrSubInfinite = errors.New("cannot subtract infinite dates")
+	// ErrSubOutOfRange is returned (wrapped) by `SubErr()` when the year, month
+	// or day of either date is so large in magnitude that the number of days
+	// between them may not fit in an `int64`.
+	ErrSubOutOfRange = errors.New("date out of range for subtraction")
+)
+
+// ParseError describes a problem parsing a date string, e.g. in `FromString()`,
+// `UnmarshalJSON()` or `Scan()`. It wraps the underlying `*time.ParseError`, so
+// `errors.As()` may be used for either type.
+type ParseError struct {
+	// Input is the string that could not be parsed.
+	Input string
+	// Layout is the expected layout, e.g. `2006-01-02`.
+	Layout string
+	// Offset is the byte offset in `Input` where parsing failed.
+	Offset int
+	// Err is the underlying error.
+	Err error
+}
+
+// rangeErrorElements are the layout elements (as reported in
+// `time.ParseError{}.LayoutElem`) that may fail a range check, keyed by the
+// message reported by `time.Parse()`.
+var rangeErrorElements = map[string][]string{
+	": month out of range": {"01", "1"},
+	": day out of range":   {"02", "_2", "2"},
+}
+
+// newParseError converts an error from `time.Parse()` into a `*ParseError`.
+// For a range error (e.g. 2024-13-01), the offset is the start of the element
+// that is out of range.
+func newParseError(input, layout string, err error) *ParseError {
+	offset := 0
+	var tpe *time.ParseError
+	if errors.As(err, &tpe) && len(tpe.ValueElem) <= len(input) {
+		offset = len(input) - len(tpe.ValueElem)
+		if elements, ok := rangeErrorElements[tpe.Message]; ok {
+			if start, ok := elementOffset(input, layout, elements); ok {
+				offset = start
+			}
+		}
+	}
+
+	return &ParseError{Input: input, Layout: layout, Offset: offset, Err: err}
+}
+
+// elementOffset returns the byte offset in `input` where one of the layout
+// `elements` begins. Since `time.Parse()` only reports a range error after
+// the element has been consumed (or after the entire value has been consumed,
+// for the day), this finds the shortest prefix of `input` that runs out
+// exactly at the element.
+func elementOffset(input, layout string, elements []string) (int, bool) {
+	for i := 0; i <= len(input); i++ {
+		_, err := time.Parse(layout, input[:i])
+		var tpe *time.ParseError
+		if !errors.As(err, &tpe) || tpe.ValueElem != "" {
+			continue
+		}
+
+		for _, element := range elements {
+			if tpe.LayoutElem != element {
+				continue
+			}
+
+			// NOTE: `time.Parse()` allows spaces in the layout to match the
+			//       end of the value, so skip any spaces before the element.
+			for i < len(input) && input[i] == ' ' {
+				i++
+			}
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Error implements `error`; this is the message of the underlying error.
+func (pe *ParseError) Error() string {
+	return pe.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (pe *ParseError) Unwrap() error {
+	return pe.Err
+}
+
+// Is returns true if `target` is `ErrInvalidDate` and the input failed to parse
+// because the month or day is out of range (e.g. 2024-02-30).
+func (pe *ParseError) Is(target error) bool {
+	if target != ErrInvalidDate {
+		return false
+	}
+
+	var tpe *time.ParseError
+	return errors.As(pe.Err, &tpe) && strings.HasSuffix(tpe.Message, " out of range")
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestFromString_ParseError(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input   string
+		Offset  int
+		Invalid bool
+		Error   string
+	}
+
+	cases := []testCase{
+		{
+			Input:  "x",
+			Offset: 0,
+			Error:  `parsing time "x" as "2006-01-02": cannot parse "x" as "2006"`,
+		},
+		{
+			Input:  "2024/01/02",
+			Offset: 4,
+			Error:  `parsing time "2024/01/02" as "2006-01-02": cannot parse "/01/02" as "-"`,
+		},
+		{
+			Input:  "2024-01-02T00:00:00Z",
+			Offset: 10,
+			Error:  `parsing time "2024-01-02T00:00:00Z": extra text: "T00:00:00Z"`,
+		},
+		{
+			Input:   "2024-02-30",
+			Offset:  8,
+			Invalid: true,
+			Error:   `parsing time "2024-02-30": day out of range`,
+		},
+		{
+			Input:   "2024-13-01",
+			Offset:  5,
+			Invalid: true,
+			Error:   `parsing time "2024-13-01": month out of range`,
+		},
+		{
+			Input:   "2024-00-10",
+			Offset:  5,
+			Invalid: true,
+			Error:   `parsing time "2024-00-10": month out of range`,
+		},
+		{
+			Input:   "2024-01-32",
+			Offset:  8,
+			Invalid: true,
+			Error:   `parsing time "2024-01-32": day out of range`,
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d, err := date.FromString(tc.Input)
+			assert.Equal(date.Date{}, d)
+			assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+
+			var pe *date.ParseError
+			assert.True(errors.As(err, &pe))
+			assert.Equal(tc.Input, pe.Input)
+			assert.Equal(time.DateOnly, pe.Layout)
+			assert.Equal(tc.Offset, pe.Offset)
+
+			var tpe *time.ParseError
+			assert.True(errors.As(err, &tpe))
+			assert.Equal(tc.Invalid, errors.Is(err, date.ErrInvalidDate))
+			assert.False(errors.Is(err, date.ErrNotDateOnly))
+		})
+	}
+}
+
+func TestParse_ParseErrorOffset(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Layout string
+		Input  string
+		Offset int
+	}
+
+	cases := []testCase{
+		{Layout: "Jan 2, 2006", Input: "Feb 30, 2024", Offset: 4},
+		{Layout: "Jan _2, 2006", Input: "Feb 30, 2024", Offset: 4},
+		{Layout: "1/2/2006", Input: "2/30/2024", Offset: 2},
+		{Layout: "1/2/2006", Input: "13/1/2024", Offset: 0},
+		{Layout: "02.01.2006", Input: "31.04.2024", Offset: 0},
+		{Layout: "20060102", Input: "20241301", Offset: 4},
+		{Layout: "20060102", Input: "20230229", Offset: 6},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d, err := date.Parse(tc.Layout, tc.Input)
+			assert.Equal(date.Date{}, d)
+			assert.True(errors.Is(err, date.ErrInvalidDate))
+
+			var pe *date.ParseError
+			assert.True(errors.As(err, &pe))
+			assert.Equal(tc.Layout, pe.Layout)
+			assert.Equal(tc.Offset, pe.Offset)
+		})
+	}
+}
+
+func TestFromTime_ErrNotDateOnly(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	_, err := date.FromTime(time.Date(2024, time.January, 2, 3, 0, 0, 0, time.UTC))
+	assert.True(errors.Is(err, date.ErrNotDateOnly))
+	assert.False(errors.Is(err, date.ErrInvalidDate))
+	assert.Equal("timestamp contains more than just date information; 2024-01-02T03:00:00Z", fmt.Sprintf("%v", err))
+}
+
+func TestDate_Unmarshal_TypedErrors(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	var pe *date.ParseError
+	d := date.Date{}
+
+	err := json.Unmarshal([]byte(`"2024-02-30"`), &d)
+	assert.True(errors.As(err, &pe))
+	assert.Equal("2024-02-30", pe.Input)
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+
+	err = d.UnmarshalText([]byte("01/02/2024"))
+	assert.True(errors.As(err, &pe))
+	assert.Equal("01/02/2024", pe.Input)
+	assert.False(errors.Is(err, date.ErrInvalidDate))
+
+	nd := date.NullDate{}
+	err = nd.UnmarshalJSON([]byte(`"2024-04-31"`))
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+}
+
+func TestDate_Scan_TypedErrors(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.Date{}
+	err := d.Scan(time.Date(2024, time.January, 2, 0, 0, 0, 1, time.UTC))
+	assert.True(errors.Is(err, date.ErrNotDateOnly))
+
+	err = d.Scan("2024-01-02 03:04:05")
+	assert.True(errors.Is(err, date.ErrNotDateOnly))
+
+	err = d.Scan([]byte("2023-02-29"))
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+
+	var pe *date.ParseError
+	err = d.Scan("2024-02-30 00:00:00")
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+	assert.True(errors.As(err, &pe))
+	assert.Equal("2024-02-30 00:00:00", pe.Input)
+	assert.Equal("2006-01-02 15:04:05.999999999", pe.Layout)
+	assert.Equal(8, pe.Offset)
+
+	err = d.Scan("2024-13-01T00:00:00Z")
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+	assert.True(errors.As(err, &pe))
+	assert.Equal(5, pe.Offset)
+
+	err = d.Scan("garbage")
+	assert.True(errors.As(err, &pe))
+	assert.Equal("garbage", pe.Input)
+
+	nd := date.NullDate{}
+	err = nd.Scan(time.Date(2024, time.January, 2, 0, 0, 0, 0, time.FixedZone("", 3600)))
+	assert.True(errors.Is(err, date.ErrNotDateOnly))
+}
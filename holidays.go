@@ -0,0 +1,434 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"sort"
+	"time"
+)
+
+// Observance determines how a holiday that falls on a weekend is shifted
+// to the date on which it is observed.
+type Observance int
+
+const (
+	// ObserveActual observes a holiday on the date it falls on, even if that
+	// date is a weekend.
+	ObserveActual Observance = iota
+	// ObserveNearestWeekday observes a holiday that falls on a Saturday on the
+	// preceding Friday and a holiday that falls on a Sunday on the following
+	// Monday. This is the rule used for US federal holidays.
+	ObserveNearestWeekday
+	// ObserveSundayToMonday observes a holiday that falls on a Sunday on the
+	// following Monday; a holiday that falls on a Saturday is not shifted
+	// (i.e. it is effectively not observed on a business day). This is the
+	// rule used by the Federal Reserve.
+	ObserveSundayToMonday
+)
+
+// HolidayConfig helps customize the behavior of a `HolidayRule`.
+type HolidayConfig struct {
+	Observance  Observance
+	FromYear    int
+	ToYear      int
+	ExceptYears []int
+	Except      func(d Date) bool
+}
+
+// HolidayOption defines a function that will be applied to a holiday config.
+type HolidayOption func(*HolidayConfig)
+
+// OptHolidayObservance returns an option that sets the weekend observance
+// rule on a holiday config.
+func OptHolidayObservance(observance Observance) HolidayOption {
+	return func(hc *HolidayConfig) {
+		hc.Observance = observance
+	}
+}
+
+// OptHolidayFromYear returns an option that sets the first year that a
+// holiday is observed on a holiday config.
+func OptHolidayFromYear(year int) HolidayOption {
+	return func(hc *HolidayConfig) {
+		hc.FromYear = year
+	}
+}
+
+// OptHolidayToYear returns an option that sets the last year that a holiday is
+// observed on a holiday config.
+func OptHolidayToYear(year int) HolidayOption {
+	return func(hc *HolidayConfig) {
+		hc.ToYear = year
+	}
+}
+
+// OptHolidayExceptYears returns an option that sets years in which a holiday
+// is **not** observed on a holiday config (e.g. when a market opens for an
+// abbreviated session rather than closing).
+func OptHolidayExceptYears(years ...int) HolidayOption {
+	return func(hc *HolidayConfig) {
+		hc.ExceptYears = append(hc.ExceptYears, years...)
+	}
+}
+
+// OptHolidayExcept returns an option that sets a predicate on a holiday
+// config; the holiday is **not** observed in years when the predicate returns
+// true for the (unshifted) date of the holiday. This is useful when the
+// exceptions follow a rule rather than a fixed list of years.
+func OptHolidayExcept(except func(d Date) bool) HolidayOption {
+	return func(hc *HolidayConfig) {
+		hc.Except = except
+	}
+}
+
+// HolidayRule computes the date a holiday is observed on in a given year.
+type HolidayRule struct {
+	Name   string
+	config HolidayConfig
+	date   func(year int) (Date, bool)
+}
+
+// NewFixedHoliday returns a rule for a holiday that falls on the same month
+// and day every year, e.g. Independence Day on July 4.
+func NewFixedHoliday(name string, month time.Month, day int, opts ...HolidayOption) HolidayRule {
+	date := func(year int) (Date, bool) {
+		return Date{Year: year, Month: month, Day: day}, true
+	}
+	return newHolidayRule(name, date, opts)
+}
+
+// NewNthWeekdayHoliday returns a rule for a holiday that falls on the `n`th
+// occurrence of a weekday in a month, e.g. Thanksgiving on the 4th Thursday of
+// November. A negative `n` counts from the end of the month, e.g. `-1` for
+// Memorial Day on the last Monday of May.
+func NewNthWeekdayHoliday(name string, month time.Month, weekday time.Weekday, n int, opts ...HolidayOption) HolidayRule {
+	date := func(year int) (Date, bool) {
+		return nthWeekdayOfMonth(year, month, weekday, n)
+	}
+	return newHolidayRule(name, date, opts)
+}
+
+// NewEasterHoliday returns a rule for a holiday that falls a fixed number of
+// days from (Western) Easter Sunday, e.g. Good Friday with an offset of `-2`.
+func NewEasterHoliday(name string, offset int, opts ...HolidayOption) HolidayRule {
+	date := func(year int) (Date, bool) {
+		return Easter(year).AddDays(offset), true
+	}
+	return newHolidayRule(name, date, opts)
+}
+
+// NewSpecialHoliday returns a rule for a one-off holiday, e.g. an unscheduled
+// market closure. The holiday is never shifted for weekends.
+func NewSpecialHoliday(name string, d Date) HolidayRule {
+	date := func(year int) (Date, bool) {
+		return d, year == d.Year
+	}
+	return newHolidayRule(name, date, nil)
+}
+
+func newHolidayRule(name string, date func(year int) (Date, bool), opts []HolidayOption) HolidayRule {
+	hc := HolidayConfig{Observance: ObserveActual}
+	for _, opt := range opts {
+		opt(&hc)
+	}
+
+	return HolidayRule{Name: name, config: hc, date: date}
+}
+
+// Date returns the date on which the holiday is observed for the given year.
+// If the holiday is not observed in that year, `false` will be returned.
+//
+// NOTE: Due to weekend observance, the observed date may fall in a different
+// year; e.g. New Year's Day 2022 fell on a Saturday and was observed as a
+// federal holiday on Friday, December 31, 2021.
+func (hr HolidayRule) Date(year int) (Date, bool) {
+	if hr.date == nil {
+		return Date{}, false
+	}
+	if hr.config.FromYear != 0 && year < hr.config.FromYear {
+		return Date{}, false
+	}
+	if hr.config.ToYear != 0 && year > hr.config.ToYear {
+		return Date{}, false
+	}
+	for _, except := range hr.config.ExceptYears {
+		if year == except {
+			return Date{}, false
+		}
+	}
+
+	d, ok := hr.date(year)
+	if !ok {
+		return Date{}, false
+	}
+	if hr.config.Except != nil && hr.config.Except(d) {
+		return Date{}, false
+	}
+
+	return observe(d, hr.config.Observance), true
+}
+
+func observe(d Date, observance Observance) Date {
+	switch observance {
+	case ObserveNearestWeekday:
+		switch d.Weekday() {
+		case time.Saturday:
+			return d.AddDays(-1)
+		case time.Sunday:
+			return d.AddDays(1)
+		}
+	case ObserveSundayToMonday:
+		if d.Weekday() == time.Sunday {
+			return d.AddDays(1)
+		}
+	}
+
+	return d
+}
+
+// HolidaySet is a named collection of holiday rules, e.g. the holidays
+// observed by a given market. It can be used as the source of holidays for a
+// `Calendar` via `OptCalendarHolidaySets()`.
+type HolidaySet struct {
+	Name  string
+	Rules []HolidayRule
+}
+
+// IsHoliday returns true if any rule in the set is observed on the date.
+func (hs HolidaySet) IsHoliday(d Date) bool {
+	// NOTE: Observance may shift a holiday into an adjacent year, so the
+	//       neighboring years must be checked as well.
+	for year := d.Year - 1; year <= d.Year+1; year++ {
+		for _, rule := range hs.Rules {
+			observed, ok := rule.Date(year)
+			if ok && observed.Equal(d) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Holidays returns the sorted (and de-duplicated) observed holidays that fall
+// in the given year. Holidays that are not shifted by their observance rule
+// may fall on a weekend.
+func (hs HolidaySet) Holidays(year int) []Date {
+	seen := map[Date]struct{}{}
+	result := []Date{}
+	for ruleYear := year - 1; ruleYear <= year+1; ruleYear++ {
+		for _, rule := range hs.Rules {
+			observed, ok := rule.Date(ruleYear)
+			if !ok || observed.Year != year {
+				continue
+			}
+			if _, ok := seen[observed]; ok {
+				continue
+			}
+
+			seen[observed] = struct{}{}
+			result = append(result, observed)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Before(result[j])
+	})
+	return result
+}
+
+// USFederalHolidays returns the legal public holidays for US federal
+// employees (5 U.S.C. 6103), as published by the Office of Personnel
+// Management. Holidays falling on a Saturday are observed on the preceding
+// Friday and holidays falling on a Sunday are observed on the following
+// Monday.
+//
+// Rules reflect the current observance of each holiday (e.g. Columbus Day on
+// the second Monday of October) and are not accurate for years before 1986.
+// Inauguration Day (only observed in the Washington, DC area) is not included.
+func USFederalHolidays() HolidaySet {
+	observed := OptHolidayObservance(ObserveNearestWeekday)
+	return HolidaySet{
+		Name: "US Federal",
+		Rules: []HolidayRule{
+			NewFixedHoliday("New Year's Day", time.January, 1, observed),
+			NewNthWeekdayHoliday("Birthday of Martin Luther King, Jr.", time.January, time.Monday, 3, OptHolidayFromYear(1986)),
+			NewNthWeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+			NewNthWeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+			NewFixedHoliday("Juneteenth National Independence Day", time.June, 19, observed, OptHolidayFromYear(2021)),
+			NewFixedHoliday("Independence Day", time.July, 4, observed),
+			NewNthWeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+			NewNthWeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+			NewFixedHoliday("Veterans Day", time.November, 11, observed),
+			NewNthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+			NewFixedHoliday("Christmas Day", time.December, 25, observed),
+		},
+	}
+}
+
+// FederalReserveHolidays returns the holidays observed by the Federal Reserve
+// Banks (and therefore by Fedwire and the ACH network). These match the US
+// federal holidays except that holidays falling on a Saturday are **not**
+// observed on the preceding Friday.
+//
+// Rules are not accurate for years before 1986.
+func FederalReserveHolidays() HolidaySet {
+	observed := OptHolidayObservance(ObserveSundayToMonday)
+	return HolidaySet{
+		Name: "Federal Reserve",
+		Rules: []HolidayRule{
+			NewFixedHoliday("New Year's Day", time.January, 1, observed),
+			NewNthWeekdayHoliday("Birthday of Martin Luther King, Jr.", time.January, time.Monday, 3, OptHolidayFromYear(1986)),
+			NewNthWeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+			NewNthWeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+			NewFixedHoliday("Juneteenth National Independence Day", time.June, 19, observed, OptHolidayFromYear(2022)),
+			NewFixedHoliday("Independence Day", time.July, 4, observed),
+			NewNthWeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+			NewNthWeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+			NewFixedHoliday("Veterans Day", time.November, 11, observed),
+			NewNthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+			NewFixedHoliday("Christmas Day", time.December, 25, observed),
+		},
+	}
+}
+
+// NYSEHolidays returns the full-day market closures of the New York Stock
+// Exchange. Holidays falling on a Saturday are observed on the preceding
+// Friday, except for New Year's Day (the exchange stays open on the last
+// business day of the year). Holidays falling on a Sunday are observed on the
+// following Monday.
+//
+// Unscheduled closures since 2001 (e.g. Hurricane Sandy and national days of
+// mourning) are included as special holidays. Early closes are not
+// holidays and are not included.
+func NYSEHolidays() HolidaySet {
+	observed := OptHolidayObservance(ObserveNearestWeekday)
+	return HolidaySet{
+		Name: "NYSE",
+		Rules: []HolidayRule{
+			NewFixedHoliday("New Year's Day", time.January, 1, OptHolidayObservance(ObserveSundayToMonday)),
+			NewNthWeekdayHoliday("Martin Luther King, Jr. Day", time.January, time.Monday, 3, OptHolidayFromYear(1998)),
+			NewNthWeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+			NewEasterHoliday("Good Friday", -2),
+			NewNthWeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+			NewFixedHoliday("Juneteenth National Independence Day", time.June, 19, observed, OptHolidayFromYear(2022)),
+			NewFixedHoliday("Independence Day", time.July, 4, observed),
+			NewNthWeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+			NewNthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+			NewFixedHoliday("Christmas Day", time.December, 25, observed),
+			NewSpecialHoliday("September 11 Attacks", NewDate(2001, time.September, 11)),
+			NewSpecialHoliday("September 11 Attacks", NewDate(2001, time.September, 12)),
+			NewSpecialHoliday("September 11 Attacks", NewDate(2001, time.September, 13)),
+			NewSpecialHoliday("September 11 Attacks", NewDate(2001, time.September, 14)),
+			NewSpecialHoliday("National Day of Mourning for Ronald Reagan", NewDate(2004, time.June, 11)),
+			NewSpecialHoliday("National Day of Mourning for Gerald Ford", NewDate(2007, time.January, 2)),
+			NewSpecialHoliday("Hurricane Sandy", NewDate(2012, time.October, 29)),
+			NewSpecialHoliday("Hurricane Sandy", NewDate(2012, time.October, 30)),
+			NewSpecialHoliday("National Day of Mourning for George H.W. Bush", NewDate(2018, time.December, 5)),
+			NewSpecialHoliday("National Day of Mourning for Jimmy Carter", NewDate(2025, time.January, 9)),
+		},
+	}
+}
+
+// SIFMAHolidays returns the full-day closures recommended by SIFMA for the US
+// bond markets. Holidays falling on a Saturday are observed on the preceding
+// Friday, except for New Year's Day. Holidays falling on a Sunday are observed
+// on the following Monday.
+//
+// In years when Good Friday coincides with the release of the monthly US
+// employment report, SIFMA has recommended an early close rather than a
+// full close (e.g. 2012, 2015, 2021, 2023 and 2026); those years are excluded
+// from the Good Friday rule. The March report is usually released on the
+// first Friday of April, so this is modeled as Good Friday falling on April 1
+// through 7.
+//
+// NOTE: SIFMA publishes its recommendations year by year and the release
+// schedule occasionally moves (e.g. after a government shutdown). Any
+// deviation from this rule must be maintained by hand.
+func SIFMAHolidays() HolidaySet {
+	observed := OptHolidayObservance(ObserveNearestWeekday)
+	return HolidaySet{
+		Name: "SIFMA",
+		Rules: []HolidayRule{
+			NewFixedHoliday("New Year's Day", time.January, 1, OptHolidayObservance(ObserveSundayToMonday)),
+			NewNthWeekdayHoliday("Martin Luther King, Jr. Day", time.January, time.Monday, 3, OptHolidayFromYear(1998)),
+			NewNthWeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+			NewEasterHoliday("Good Friday", -2, OptHolidayExcept(isEmploymentReportDay)),
+			NewNthWeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+			NewFixedHoliday("Juneteenth National Independence Day", time.June, 19, observed, OptHolidayFromYear(2022)),
+			NewFixedHoliday("Independence Day", time.July, 4, observed),
+			NewNthWeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+			NewNthWeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+			NewFixedHoliday("Veterans Day", time.November, 11, observed),
+			NewNthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+			NewFixedHoliday("Christmas Day", time.December, 25, observed),
+		},
+	}
+}
+
+// isEmploymentReportDay returns true if a Good Friday is (likely) also the
+// release date of the monthly US employment report, i.e. the first Friday of
+// April.
+func isEmploymentReportDay(d Date) bool {
+	return d.Month == time.April && d.Day <= 7
+}
+
+// Easter returns the date of (Western) Easter Sunday in the given year, using
+// the "Anonymous Gregorian algorithm" (also known as the Meeus/Jones/Butcher
+// algorithm).
+func Easter(year int) Date {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return Date{Year: year, Month: time.Month(month), Day: day}
+}
+
+// nthWeekdayOfMonth returns the `n`th occurrence of a weekday in a month; a
+// negative `n` counts from the end of the month. If there is no such
+// occurrence (e.g. a 5th Monday), `false` will be returned.
+func nthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) (Date, bool) {
+	if n > 0 {
+		first := Date{Year: year, Month: month, Day: 1}
+		offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+		day := 1 + offset + 7*(n-1)
+		if day > daysIn(month, year) {
+			return Date{}, false
+		}
+		return Date{Year: year, Month: month, Day: day}, true
+	}
+
+	if n < 0 {
+		last := Date{Year: year, Month: month, Day: daysIn(month, year)}
+		offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+		day := last.Day - offset - 7*(-n-1)
+		if day < 1 {
+			return Date{}, false
+		}
+		return Date{Year: year, Month: month, Day: day}, true
+	}
+
+	return Date{}, false
+}
@@ -0,0 +1,316 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestHolidaySet_Holidays(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Set      date.HolidaySet
+		Year     int
+		Expected []string
+	}
+
+	cases := []testCase{
+		{
+			Set:  date.USFederalHolidays(),
+			Year: 2021,
+			Expected: []string{
+				"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31", "2021-06-18", "2021-07-05",
+				"2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25", "2021-12-24", "2021-12-31",
+			},
+		},
+		{
+			Set:  date.USFederalHolidays(),
+			Year: 2022,
+			Expected: []string{
+				"2022-01-17", "2022-02-21", "2022-05-30", "2022-06-20", "2022-07-04",
+				"2022-09-05", "2022-10-10", "2022-11-11", "2022-11-24", "2022-12-26",
+			},
+		},
+		{
+			Set:  date.USFederalHolidays(),
+			Year: 2024,
+			Expected: []string{
+				"2024-01-01", "2024-01-15", "2024-02-19", "2024-05-27", "2024-06-19", "2024-07-04",
+				"2024-09-02", "2024-10-14", "2024-11-11", "2024-11-28", "2024-12-25",
+			},
+		},
+		{
+			Set:  date.FederalReserveHolidays(),
+			Year: 2021,
+			Expected: []string{
+				"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31", "2021-07-05",
+				"2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25", "2021-12-25",
+			},
+		},
+		{
+			Set:  date.FederalReserveHolidays(),
+			Year: 2022,
+			Expected: []string{
+				"2022-01-01", "2022-01-17", "2022-02-21", "2022-05-30", "2022-06-20", "2022-07-04",
+				"2022-09-05", "2022-10-10", "2022-11-11", "2022-11-24", "2022-12-26",
+			},
+		},
+		{
+			Set:  date.FederalReserveHolidays(),
+			Year: 2026,
+			Expected: []string{
+				"2026-01-01", "2026-01-19", "2026-02-16", "2026-05-25", "2026-06-19", "2026-07-04",
+				"2026-09-07", "2026-10-12", "2026-11-11", "2026-11-26", "2026-12-25",
+			},
+		},
+		{
+			Set:  date.NYSEHolidays(),
+			Year: 2021,
+			Expected: []string{
+				"2021-01-01", "2021-01-18", "2021-02-15", "2021-04-02", "2021-05-31",
+				"2021-07-05", "2021-09-06", "2021-11-25", "2021-12-24",
+			},
+		},
+		{
+			Set:  date.NYSEHolidays(),
+			Year: 2024,
+			Expected: []string{
+				"2024-01-01", "2024-01-15", "2024-02-19", "2024-03-29", "2024-05-27",
+				"2024-06-19", "2024-07-04", "2024-09-02", "2024-11-28", "2024-12-25",
+			},
+		},
+		{
+			Set:  date.NYSEHolidays(),
+			Year: 2025,
+			Expected: []string{
+				"2025-01-01", "2025-01-09", "2025-01-20", "2025-02-17", "2025-04-18", "2025-05-26",
+				"2025-06-19", "2025-07-04", "2025-09-01", "2025-11-27", "2025-12-25",
+			},
+		},
+		{
+			Set:  date.NYSEHolidays(),
+			Year: 2026,
+			Expected: []string{
+				"2026-01-01", "2026-01-19", "2026-02-16", "2026-04-03", "2026-05-25",
+				"2026-06-19", "2026-07-03", "2026-09-07", "2026-11-26", "2026-12-25",
+			},
+		},
+		{
+			Set:  date.SIFMAHolidays(),
+			Year: 2023,
+			Expected: []string{
+				"2023-01-02", "2023-01-16", "2023-02-20", "2023-05-29", "2023-06-19", "2023-07-04",
+				"2023-09-04", "2023-10-09", "2023-11-10", "2023-11-23", "2023-12-25",
+			},
+		},
+		{
+			Set:  date.SIFMAHolidays(),
+			Year: 2024,
+			Expected: []string{
+				"2024-01-01", "2024-01-15", "2024-02-19", "2024-03-29", "2024-05-27", "2024-06-19",
+				"2024-07-04", "2024-09-02", "2024-10-14", "2024-11-11", "2024-11-28", "2024-12-25",
+			},
+		},
+		{
+			Set:  date.SIFMAHolidays(),
+			Year: 2021,
+			Expected: []string{
+				"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31", "2021-07-05",
+				"2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25", "2021-12-24",
+			},
+		},
+		{
+			Set:  date.SIFMAHolidays(),
+			Year: 2026,
+			Expected: []string{
+				"2026-01-01", "2026-01-19", "2026-02-16", "2026-05-25", "2026-06-19", "2026-07-03",
+				"2026-09-07", "2026-10-12", "2026-11-11", "2026-11-26", "2026-12-25",
+			},
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s:%d", tc.Set.Name, tc.Year)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			holidays := tc.Set.Holidays(tc.Year)
+			computed := make([]string, len(holidays))
+			for j, holiday := range holidays {
+				computed[j] = holiday.String()
+				assert.True(tc.Set.IsHoliday(holiday))
+				assert.False(tc.Set.IsHoliday(holiday.AddDays(-1)) && tc.Set.IsHoliday(holiday.AddDays(1)))
+			}
+			assert.Equal(tc.Expected, computed)
+		})
+	}
+}
+
+func TestHolidaySet_IsHoliday(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	federal := date.USFederalHolidays()
+	fed := date.FederalReserveHolidays()
+
+	// New Year's Day 2022 fell on a Saturday
+	assert.True(federal.IsHoliday(date.NewDate(2021, time.December, 31)))
+	assert.False(federal.IsHoliday(date.NewDate(2022, time.January, 1)))
+	assert.False(fed.IsHoliday(date.NewDate(2021, time.December, 31)))
+	assert.True(fed.IsHoliday(date.NewDate(2022, time.January, 1)))
+
+	// Christmas 2022 fell on a Sunday
+	assert.True(federal.IsHoliday(date.NewDate(2022, time.December, 26)))
+	assert.True(fed.IsHoliday(date.NewDate(2022, time.December, 26)))
+	assert.False(federal.IsHoliday(date.NewDate(2022, time.December, 25)))
+
+	// Juneteenth
+	assert.False(federal.IsHoliday(date.NewDate(2020, time.June, 19)))
+	assert.False(fed.IsHoliday(date.NewDate(2021, time.June, 18)))
+}
+
+func TestHolidayRule_Date(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	// Fifth Monday
+	rule := date.NewNthWeekdayHoliday("Fifth Monday", time.February, time.Monday, 5)
+	d, ok := rule.Date(2024)
+	assert.False(ok)
+	assert.Equal(date.Date{}, d)
+	_, ok = rule.Date(2016)
+	assert.True(ok)
+
+	// Second to last Friday
+	rule = date.NewNthWeekdayHoliday("Second to Last Friday", time.March, time.Friday, -2)
+	d, ok = rule.Date(2024)
+	assert.True(ok)
+	assert.Equal(date.NewDate(2024, time.March, 22), d)
+
+	// Zero is not a valid occurrence
+	rule = date.NewNthWeekdayHoliday("Zero", time.March, time.Friday, 0)
+	_, ok = rule.Date(2024)
+	assert.False(ok)
+
+	// Years
+	rule = date.NewFixedHoliday(
+		"Fixed",
+		time.March,
+		3,
+		date.OptHolidayFromYear(2000),
+		date.OptHolidayToYear(2010),
+		date.OptHolidayExceptYears(2005),
+	)
+	_, ok = rule.Date(1999)
+	assert.False(ok)
+	_, ok = rule.Date(2000)
+	assert.True(ok)
+	_, ok = rule.Date(2005)
+	assert.False(ok)
+	_, ok = rule.Date(2010)
+	assert.True(ok)
+	_, ok = rule.Date(2011)
+	assert.False(ok)
+
+	// Except
+	rule = date.NewFixedHoliday(
+		"Fixed",
+		time.March,
+		3,
+		date.OptHolidayExcept(func(d date.Date) bool { return d.Weekday() == time.Sunday }),
+	)
+	_, ok = rule.Date(2024)
+	assert.False(ok)
+	d, ok = rule.Date(2025)
+	assert.True(ok)
+	assert.Equal(date.NewDate(2025, time.March, 3), d)
+
+	// Special
+	rule = date.NewSpecialHoliday("Special", date.NewDate(2018, time.December, 5))
+	d, ok = rule.Date(2018)
+	assert.True(ok)
+	assert.Equal(date.NewDate(2018, time.December, 5), d)
+	_, ok = rule.Date(2019)
+	assert.False(ok)
+
+	// Zero value
+	rule = date.HolidayRule{}
+	_, ok = rule.Date(2018)
+	assert.False(ok)
+}
+
+func TestEaster(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Year     int
+		Expected string
+	}
+
+	cases := []testCase{
+		{Year: 1818, Expected: "1818-03-22"},
+		{Year: 1943, Expected: "1943-04-25"},
+		{Year: 2000, Expected: "2000-04-23"},
+		{Year: 2019, Expected: "2019-04-21"},
+		{Year: 2024, Expected: "2024-03-31"},
+		{Year: 2025, Expected: "2025-04-20"},
+		{Year: 2038, Expected: "2038-04-25"},
+		{Year: 2285, Expected: "2285-03-22"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Expected, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			easter := date.Easter(tc.Year)
+			assert.Equal(tc.Expected, easter.String())
+			assert.Equal(time.Sunday, easter.Weekday())
+		})
+	}
+}
+
+func TestCalendar_HolidaySets(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	c, err := date.NewCalendar(date.OptCalendarHolidaySets(date.NYSEHolidays()))
+	assert.Nil(err)
+
+	assert.False(c.IsBusinessDay(date.NewDate(2024, time.March, 29)))
+	assert.Equal("2024-04-01", c.NextBusinessDay(date.NewDate(2024, time.March, 28)).String())
+
+	start := date.NewDate(2024, time.January, 1)
+	end := date.NewDate(2025, time.January, 1)
+	assert.Equal(int64(252), c.BusinessDaysBetween(start, end))
+
+	start = date.NewDate(2025, time.January, 1)
+	end = date.NewDate(2026, time.January, 1)
+	assert.Equal(int64(250), c.BusinessDaysBetween(start, end))
+}
@@ -0,0 +1,231 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	quarterlyMonths  = []time.Month{time.March, time.June, time.September, time.December}
+	semiannualMonths = []time.Month{time.March, time.September}
+	allMonths        = []time.Month{
+		time.January, time.February, time.March, time.April, time.May, time.June,
+		time.July, time.August, time.September, time.October, time.November, time.December,
+	}
+	// immMonthCodes are the futures month codes, e.g. `H` for March.
+	immMonthCodes = map[byte]time.Month{
+		'F': time.January,
+		'G': time.February,
+		'H': time.March,
+		'J': time.April,
+		'K': time.May,
+		'M': time.June,
+		'N': time.July,
+		'Q': time.August,
+		'U': time.September,
+		'V': time.October,
+		'X': time.November,
+		'Z': time.December,
+	}
+)
+
+// cdsDayOfMonth is the day of the month for CDS dates.
+const cdsDayOfMonth = 20
+
+// ThirdWednesday returns the third Wednesday of the month.
+func ThirdWednesday(year int, month time.Month) Date {
+	d, _ := nthWeekdayOfMonth(year, month, time.Wednesday, 3)
+	return d
+}
+
+// ThirdFriday returns the third Friday of the month, i.e. the standard monthly
+// options expiration date (before any holiday adjustment).
+func ThirdFriday(year int, month time.Month) Date {
+	d, _ := nthWeekdayOfMonth(year, month, time.Friday, 3)
+	return d
+}
+
+// IsIMMDate returns true if the date is an IMM date, i.e. the third Wednesday
+// of March, June, September or December.
+func IsIMMDate(d Date) bool {
+	return containsMonth(quarterlyMonths, d.Month) && d == ThirdWednesday(d.Year, d.Month)
+}
+
+// NextIMMDate returns the first IMM date strictly after `d`. An infinite date
+// is returned unchanged.
+func NextIMMDate(d Date) Date {
+	return nextMonthlyDate(d, quarterlyMonths, ThirdWednesday)
+}
+
+// PreviousIMMDate returns the last IMM date strictly before `d`. An infinite
+// date is returned unchanged.
+func PreviousIMMDate(d Date) Date {
+	return previousMonthlyDate(d, quarterlyMonths, ThirdWednesday)
+}
+
+// IsThirdFriday returns true if the date is the third Friday of its month.
+func IsThirdFriday(d Date) bool {
+	return d == ThirdFriday(d.Year, d.Month)
+}
+
+// NextThirdFriday returns the first third Friday of a month strictly after
+// `d`. An infinite date is returned unchanged.
+func NextThirdFriday(d Date) Date {
+	return nextMonthlyDate(d, allMonths, ThirdFriday)
+}
+
+// PreviousThirdFriday returns the last third Friday of a month strictly
+// before `d`. An infinite date is returned unchanged.
+func PreviousThirdFriday(d Date) Date {
+	return previousMonthlyDate(d, allMonths, ThirdFriday)
+}
+
+// IsCDSDate returns true if the date is a (quarterly) CDS date, i.e. the 20th
+// of March, June, September or December. Premium payments and protection
+// periods of standard CDS contracts are aligned to these dates.
+func IsCDSDate(d Date) bool {
+	return containsMonth(quarterlyMonths, d.Month) && d.Day == cdsDayOfMonth
+}
+
+// NextCDSDate returns the first (quarterly) CDS date strictly after `d`. An
+// infinite date is returned unchanged.
+func NextCDSDate(d Date) Date {
+	return nextMonthlyDate(d, quarterlyMonths, cdsDate)
+}
+
+// PreviousCDSDate returns the last (quarterly) CDS date strictly before `d`.
+// An infinite date is returned unchanged.
+func PreviousCDSDate(d Date) Date {
+	return previousMonthlyDate(d, quarterlyMonths, cdsDate)
+}
+
+// IsCDSRollDate returns true if the date is a CDS roll date, i.e. the 20th of
+// March or September. Since 2015, the on-the-run CDS contracts (and index
+// series) roll semiannually on these dates.
+func IsCDSRollDate(d Date) bool {
+	return containsMonth(semiannualMonths, d.Month) && d.Day == cdsDayOfMonth
+}
+
+// NextCDSRollDate returns the first CDS roll date strictly after `d`. An
+// infinite date is returned unchanged.
+func NextCDSRollDate(d Date) Date {
+	return nextMonthlyDate(d, semiannualMonths, cdsDate)
+}
+
+// PreviousCDSRollDate returns the last CDS roll date strictly before `d`. An
+// infinite date is returned unchanged.
+func PreviousCDSRollDate(d Date) Date {
+	return previousMonthlyDate(d, semiannualMonths, cdsDate)
+}
+
+// ParseIMMCode parses an IMM (futures) code such as `H5`, `Z24` or `U2025`
+// into the third Wednesday of the contract month. The letter is the futures
+// month code (`F` for January through `Z` for December; `H`, `M`, `U` and `Z`
+// are the quarterly IMM months) and the digits are the year. This is
+// case-insensitive.
+//
+// A one or two digit year is resolved to the first matching year whose date
+// is on or after the reference date, e.g. `H5` is 2025-03-19 with a reference
+// date of 2024-06-01 but 2035-03-21 with a reference date of 2025-03-20.
+func ParseIMMCode(code string, reference Date) (Date, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if len(normalized) < 2 {
+		return Date{}, fmt.Errorf("invalid IMM code; %q", code)
+	}
+
+	month, ok := immMonthCodes[normalized[0]]
+	if !ok {
+		return Date{}, fmt.Errorf("invalid IMM code; %q", code)
+	}
+
+	yearStr := normalized[1:]
+	for i := 0; i < len(yearStr); i++ {
+		if yearStr[i] < '0' || yearStr[i] > '9' {
+			return Date{}, fmt.Errorf("invalid IMM code; %q", code)
+		}
+	}
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid IMM code; %q", code)
+	}
+
+	switch len(yearStr) {
+	case 1, 2:
+		modulus := 10
+		if len(yearStr) == 2 {
+			modulus = 100
+		}
+		candidate := reference.Year - floorMod(reference.Year, modulus) + year
+		if candidate < reference.Year || ThirdWednesday(candidate, month).Before(reference) {
+			candidate += modulus
+		}
+		return ThirdWednesday(candidate, month), nil
+	case 4:
+		return ThirdWednesday(year, month), nil
+	default:
+		return Date{}, fmt.Errorf("invalid IMM code; %q", code)
+	}
+}
+
+func cdsDate(year int, month time.Month) Date {
+	return Date{Year: year, Month: month, Day: cdsDayOfMonth}
+}
+
+// nextMonthlyDate returns the first date strictly after `d` produced by
+// `dateIn()` for one of the (sorted) months.
+func nextMonthlyDate(d Date, months []time.Month, dateIn func(int, time.Month) Date) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	for year := d.Year; ; year++ {
+		for _, month := range months {
+			candidate := dateIn(year, month)
+			if candidate.After(d) {
+				return candidate
+			}
+		}
+	}
+}
+
+// previousMonthlyDate returns the last date strictly before `d` produced by
+// `dateIn()` for one of the (sorted) months.
+func previousMonthlyDate(d Date, months []time.Month, dateIn func(int, time.Month) Date) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	for year := d.Year; ; year-- {
+		for i := len(months) - 1; i >= 0; i-- {
+			candidate := dateIn(year, months[i])
+			if candidate.Before(d) {
+				return candidate
+			}
+		}
+	}
+}
+
+// floorMod returns `a` modulo `b` in the range `[0, b)`; `b` must be positive.
+func floorMod(a, b int) int {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestThirdWednesdayAndFriday(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	assert.Equal("2024-03-20", date.ThirdWednesday(2024, time.March).String())
+	assert.Equal("2024-05-15", date.ThirdWednesday(2024, time.May).String())
+	assert.Equal("2025-03-19", date.ThirdWednesday(2025, time.March).String())
+	assert.Equal("2024-03-15", date.ThirdFriday(2024, time.March).String())
+	assert.Equal("2024-11-15", date.ThirdFriday(2024, time.November).String())
+	assert.Equal("2025-08-15", date.ThirdFriday(2025, time.August).String())
+}
+
+func TestIMMDates(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date     string
+		IsIMM    bool
+		Next     string
+		Previous string
+	}
+
+	cases := []testCase{
+		{Date: "2024-03-20", IsIMM: true, Next: "2024-06-19", Previous: "2023-12-20"},
+		{Date: "2024-03-19", IsIMM: false, Next: "2024-03-20", Previous: "2023-12-20"},
+		{Date: "2024-03-21", IsIMM: false, Next: "2024-06-19", Previous: "2024-03-20"},
+		{Date: "2024-05-15", IsIMM: false, Next: "2024-06-19", Previous: "2024-03-20"},
+		{Date: "2024-12-18", IsIMM: true, Next: "2025-03-19", Previous: "2024-09-18"},
+		{Date: "2024-12-31", IsIMM: false, Next: "2025-03-19", Previous: "2024-12-18"},
+		{Date: "2025-01-01", IsIMM: false, Next: "2025-03-19", Previous: "2024-12-18"},
+		{Date: "infinity", IsIMM: false, Next: "infinity", Previous: "infinity"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := mustDate(assert, tc.Date)
+			assert.Equal(tc.IsIMM, date.IsIMMDate(d))
+			assert.Equal(tc.Next, date.NextIMMDate(d).String())
+			assert.Equal(tc.Previous, date.PreviousIMMDate(d).String())
+		})
+	}
+}
+
+func TestThirdFridays(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date          string
+		IsThirdFriday bool
+		Next          string
+		Previous      string
+	}
+
+	cases := []testCase{
+		{Date: "2024-03-15", IsThirdFriday: true, Next: "2024-04-19", Previous: "2024-02-16"},
+		{Date: "2024-03-16", IsThirdFriday: false, Next: "2024-04-19", Previous: "2024-03-15"},
+		{Date: "2024-03-08", IsThirdFriday: false, Next: "2024-03-15", Previous: "2024-02-16"},
+		{Date: "2024-12-31", IsThirdFriday: false, Next: "2025-01-17", Previous: "2024-12-20"},
+		{Date: "2025-01-01", IsThirdFriday: false, Next: "2025-01-17", Previous: "2024-12-20"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := mustDate(assert, tc.Date)
+			assert.Equal(tc.IsThirdFriday, date.IsThirdFriday(d))
+			assert.Equal(tc.Next, date.NextThirdFriday(d).String())
+			assert.Equal(tc.Previous, date.PreviousThirdFriday(d).String())
+		})
+	}
+}
+
+func TestCDSDates(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date         string
+		IsCDS        bool
+		NextCDS      string
+		PreviousCDS  string
+		IsRoll       bool
+		NextRoll     string
+		PreviousRoll string
+	}
+
+	cases := []testCase{
+		{
+			Date:         "2024-03-20",
+			IsCDS:        true,
+			NextCDS:      "2024-06-20",
+			PreviousCDS:  "2023-12-20",
+			IsRoll:       true,
+			NextRoll:     "2024-09-20",
+			PreviousRoll: "2023-09-20",
+		},
+		{
+			Date:         "2024-06-20",
+			IsCDS:        true,
+			NextCDS:      "2024-09-20",
+			PreviousCDS:  "2024-03-20",
+			IsRoll:       false,
+			NextRoll:     "2024-09-20",
+			PreviousRoll: "2024-03-20",
+		},
+		{
+			Date:         "2024-06-21",
+			IsCDS:        false,
+			NextCDS:      "2024-09-20",
+			PreviousCDS:  "2024-06-20",
+			IsRoll:       false,
+			NextRoll:     "2024-09-20",
+			PreviousRoll: "2024-03-20",
+		},
+		{
+			Date:         "2024-12-25",
+			IsCDS:        false,
+			NextCDS:      "2025-03-20",
+			PreviousCDS:  "2024-12-20",
+			IsRoll:       false,
+			NextRoll:     "2025-03-20",
+			PreviousRoll: "2024-09-20",
+		},
+		{
+			Date:         "2024-01-10",
+			IsCDS:        false,
+			NextCDS:      "2024-03-20",
+			PreviousCDS:  "2023-12-20",
+			IsRoll:       false,
+			NextRoll:     "2024-03-20",
+			PreviousRoll: "2023-09-20",
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := mustDate(assert, tc.Date)
+			assert.Equal(tc.IsCDS, date.IsCDSDate(d))
+			assert.Equal(tc.NextCDS, date.NextCDSDate(d).String())
+			assert.Equal(tc.PreviousCDS, date.PreviousCDSDate(d).String())
+			assert.Equal(tc.IsRoll, date.IsCDSRollDate(d))
+			assert.Equal(tc.NextRoll, date.NextCDSRollDate(d).String())
+			assert.Equal(tc.PreviousRoll, date.PreviousCDSRollDate(d).String())
+		})
+	}
+}
+
+func TestParseIMMCode(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Code      string
+		Reference string
+		Expected  string
+		Error     string
+	}
+
+	cases := []testCase{
+		{Code: "H5", Reference: "2024-06-01", Expected: "2025-03-19"},
+		{Code: "H5", Reference: "2025-03-19", Expected: "2025-03-19"},
+		{Code: "H5", Reference: "2025-03-20", Expected: "2035-03-21"},
+		{Code: "Z4", Reference: "2024-06-01", Expected: "2024-12-18"},
+		{Code: "m3", Reference: "2024-06-01", Expected: "2033-06-15"},
+		{Code: "Z24", Reference: "2024-06-01", Expected: "2024-12-18"},
+		{Code: "U23", Reference: "2024-06-01", Expected: "2123-09-15"},
+		{Code: "F25", Reference: "2024-06-01", Expected: "2025-01-15"},
+		{Code: "U2023", Reference: "2024-06-01", Expected: "2023-09-20"},
+		{Code: "", Reference: "2024-06-01", Error: `invalid IMM code; ""`},
+		{Code: "H", Reference: "2024-06-01", Error: `invalid IMM code; "H"`},
+		{Code: "A5", Reference: "2024-06-01", Error: `invalid IMM code; "A5"`},
+		{Code: "H-5", Reference: "2024-06-01", Error: `invalid IMM code; "H-5"`},
+		{Code: "H202", Reference: "2024-06-01", Error: `invalid IMM code; "H202"`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s from %s", tc.Code, tc.Reference)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			reference := mustDate(assert, tc.Reference)
+			d, err := date.ParseIMMCode(tc.Code, reference)
+			if tc.Error != "" {
+				assert.Equal(date.Date{}, d)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Expected, d.String())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"math"
+	"time"
+)
+
+var (
+	// Infinity is a sentinel date that is after every other date; this
+	// corresponds to the Postgres `infinity` date and is serialized as
+	// `infinity`. The year is `math.MaxInt`, so no finite year orders after
+	// it.
+	Infinity = Date{Year: math.MaxInt, Month: time.December, Day: 31}
+	// NegativeInfinity is a sentinel date that is before every other date;
+	// this corresponds to the Postgres `-infinity` date and is serialized as
+	// `-infinity`. The year is `math.MinInt`, so no finite year orders before
+	// it.
+	NegativeInfinity = Date{Year: math.MinInt, Month: time.January, Day: 1}
+)
+
+const (
+	infinityString         = "infinity"
+	negativeInfinityString = "-infinity"
+)
+
+// IsInfinite returns true if the date is `Infinity` or `NegativeInfinity`.
+//
+// Infinite dates order correctly with respect to all other dates (e.g. via
+// `Before()` and `Compare()`) and are unchanged by date arithmetic such as
+// `AddDays()` and `AddMonths()`. Calendar information (e.g. `Weekday()`) and
+// `ToTime()` are not meaningful for an infinite date.
+//
+// Finite dates are only supported for years of magnitude at most 10^15 (as
+// in `SubErr()`), so date arithmetic on a supported finite date never
+// produces an infinite date.
+func (d Date) IsInfinite() bool {
+	return d == Infinity || d == NegativeInfinity
+}
+
+// infiniteFromString returns the infinite date corresponding to `s`, if `s`
+// is `infinity` or `-infinity`.
+func infiniteFromString(s string) (Date, bool) {
+	switch s {
+	case infinityString:
+		return Infinity, true
+	case negativeInfinityString:
+		return NegativeInfinity, true
+	default:
+		return Date{}, false
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestInfinity_Ordering(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(9999, time.December, 31)
+	early := date.NewDate(1, time.January, 1)
+
+	assert.True(d.Before(date.Infinity))
+	assert.True(date.Infinity.After(d))
+	assert.True(early.After(date.NegativeInfinity))
+	assert.True(date.NegativeInfinity.Before(date.Infinity))
+	assert.Equal(1, date.Infinity.Compare(d))
+	assert.Equal(-1, date.NegativeInfinity.Compare(early))
+	assert.Equal(0, date.Infinity.Compare(date.Infinity))
+	assert.True(date.Infinity.Equal(date.Infinity))
+
+	assert.True(date.Infinity.IsInfinite())
+	assert.True(date.NegativeInfinity.IsInfinite())
+	assert.False(d.IsInfinite())
+	assert.False(date.Date{}.IsInfinite())
+
+	// Very large (but supported) years still order before `Infinity`.
+	huge := date.NewDate(1_000_000_000_000_000, time.January, 1)
+	assert.True(huge.Before(date.Infinity))
+	assert.False(huge.After(date.Infinity))
+	assert.Equal(-1, huge.Compare(date.Infinity))
+	assert.True(date.NewDate(-1_000_000_000_000_000, time.January, 1).After(date.NegativeInfinity))
+
+	dr := date.NewDateRange(date.NewDate(2024, time.January, 1), date.Infinity)
+	assert.True(dr.Contains(d))
+	assert.Equal("[2024-01-01,infinity)", dr.String())
+}
+
+func TestInfinity_Arithmetic(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	for _, d := range []date.Date{date.Infinity, date.NegativeInfinity} {
+		assert.Equal(d, d.AddDays(1))
+		assert.Equal(d, d.AddDays(-1))
+		assert.Equal(d, d.AddMonths(1))
+		assert.Equal(d, d.AddMonthsStdlib(-1))
+		assert.Equal(d, d.AddYears(1))
+		assert.Equal(d, d.AddYearsStdlib(-1))
+		assert.Equal(d, d.AddPeriod(date.NewPeriod(1, 2, 3, 4)))
+		assert.Equal(d, d.MonthStart())
+		assert.Equal(d, d.MonthEnd())
+	}
+
+	// Arithmetic on a finite date does not produce an infinite date.
+	assert.False(date.NewDate(math.MaxInt32, time.December, 30).AddDays(1).IsInfinite())
+	assert.False(date.NewDate(math.MinInt32, time.January, 2).AddDays(-1).IsInfinite())
+
+	d := date.NewDate(2024, time.January, 1)
+	days, err := date.Infinity.SubErr(d)
+	assert.Equal(int64(0), days)
+	assert.NotNil(err)
+	assert.Equal("cannot subtract infinite dates; date=infinity, other=2024-01-01", fmt.Sprintf("%v", err))
+	assert.True(errors.Is(err, date.ErrSubInfinite))
+
+	days, err = d.SubErr(date.NegativeInfinity)
+	assert.Equal(int64(0), days)
+	assert.NotNil(err)
+	assert.Equal("cannot subtract infinite dates; date=2024-01-01, other=-infinity", fmt.Sprintf("%v", err))
+
+	assert.Panics(func() {
+		date.Infinity.Sub(d)
+	})
+}
+
+func TestInfinity_Serialization(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	assert.Equal("infinity", date.Infinity.String())
+	assert.Equal("-infinity", date.NegativeInfinity.String())
+	assert.Equal("infinity", date.Infinity.Format("Jan 2006"))
+	assert.Equal("date.Infinity", date.Infinity.GoString())
+	assert.Equal("date.NegativeInfinity", date.NegativeInfinity.GoString())
+
+	d, err := date.FromString("infinity")
+	assert.Nil(err)
+	assert.Equal(date.Infinity, d)
+	d, err = date.FromString("-infinity")
+	assert.Nil(err)
+	assert.Equal(date.NegativeInfinity, d)
+	_, err = date.FromString("+infinity")
+	assert.NotNil(err)
+
+	type effective struct {
+		From date.Date `json:"from"`
+		To   date.Date `json:"to"`
+	}
+	e := effective{From: date.NegativeInfinity, To: date.Infinity}
+	asBytes, err := json.Marshal(e)
+	assert.Nil(err)
+	assert.Equal(`{"from":"-infinity","to":"infinity"}`, string(asBytes))
+	parsed := effective{}
+	err = json.Unmarshal(asBytes, &parsed)
+	assert.Nil(err)
+	assert.Equal(e, parsed)
+
+	asBytes, err = date.Infinity.MarshalText()
+	assert.Nil(err)
+	assert.Equal("infinity", string(asBytes))
+	err = d.UnmarshalText([]byte("infinity"))
+	assert.Nil(err)
+	assert.Equal(date.Infinity, d)
+}
+
+func TestInfinity_SQL(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	v, err := date.Infinity.Value()
+	assert.Nil(err)
+	assert.Equal("infinity", v)
+
+	v, err = date.NullDate{Date: date.NegativeInfinity, Valid: true}.Value()
+	assert.Nil(err)
+	assert.Equal("-infinity", v)
+
+	d := date.Date{}
+	err = d.Scan("infinity")
+	assert.Nil(err)
+	assert.Equal(date.Infinity, d)
+
+	err = d.Scan([]byte("-infinity"))
+	assert.Nil(err)
+	assert.Equal(date.NegativeInfinity, d)
+
+	nd := date.NullDate{}
+	err = nd.Scan("infinity")
+	assert.Nil(err)
+	assert.Equal(date.NullDate{Date: date.Infinity, Valid: true}, nd)
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+	"math"
+)
+
+// NOTE: Ensure that
+// - `*JointCalendar` satisfies `BusinessCalendar`.
+var (
+	_ BusinessCalendar = (*JointCalendar)(nil)
+)
+
+// JointRule determines how a `JointCalendar` combines its calendars.
+type JointRule int
+
+const (
+	// JoinHolidays treats a date as a holiday if it is a holiday in any of the
+	// calendars, i.e. the holidays are the union of the holidays and the
+	// business days are the intersection of the business days. This is the
+	// usual rule for settlement, e.g. when both New York and London must be
+	// open.
+	JoinHolidays JointRule = iota
+	// JoinBusinessDays treats a date as a business day if it is a business day
+	// in any of the calendars (i.e. any calendar is open).
+	JoinBusinessDays
+)
+
+// String implements `fmt.Stringer`.
+func (jr JointRule) String() string {
+	switch jr {
+	case JoinHolidays:
+		return "JoinHolidays"
+	case JoinBusinessDays:
+		return "JoinBusinessDays"
+	default:
+		return fmt.Sprintf("JointRule(%d)", int(jr))
+	}
+}
+
+// JointCalendar is a `BusinessCalendar` that combines several calendars, e.g.
+// the New York and London calendars, according to a `JointRule`.
+//
+// Note that with `JoinHolidays`, the combined calendar must still have
+// business days; e.g. joining a Saturday / Sunday weekend with a Friday /
+// Saturday weekend is fine, but joining calendars whose weekends cover the
+// entire week is not.
+type JointCalendar struct {
+	calendars []BusinessCalendar
+	rule      JointRule
+}
+
+// NewJointCalendar returns a new `JointCalendar` that combines the calendars
+// according to the rule. An error is returned if there are no calendars, the
+// rule is not recognized or (for `JoinHolidays`) the weekends of the calendars
+// together contain every day of the week, since such a calendar would have no
+// business days.
+func NewJointCalendar(rule JointRule, calendars ...BusinessCalendar) (*JointCalendar, error) {
+	if len(calendars) == 0 {
+		return nil, fmt.Errorf("joint calendar requires at least one calendar")
+	}
+	if rule != JoinHolidays && rule != JoinBusinessDays {
+		return nil, fmt.Errorf("invalid joint calendar rule; %s", rule)
+	}
+
+	jc := &JointCalendar{
+		calendars: append([]BusinessCalendar(nil), calendars...),
+		rule:      rule,
+	}
+	for _, isWeekend := range jc.weekendDays() {
+		if !isWeekend {
+			return jc, nil
+		}
+	}
+
+	return nil, fmt.Errorf("joint calendar weekend cannot contain every day of the week")
+}
+
+// weekendDays returns the weekend days of the joint calendar, i.e. the union
+// (`JoinHolidays`) or intersection (`JoinBusinessDays`) of the weekends of
+// the calendars. Calendars that don't define a weekend are treated as having
+// no weekend days.
+func (jc *JointCalendar) weekendDays() [7]bool {
+	result := [7]bool{}
+	for day := range result {
+		result[day] = jc.rule == JoinBusinessDays
+	}
+
+	for _, c := range jc.calendars {
+		w := [7]bool{}
+		if wc, ok := c.(weekendDaysCalendar); ok {
+			w = wc.weekendDays()
+		}
+
+		for day := range result {
+			if jc.rule == JoinBusinessDays {
+				result[day] = result[day] && w[day]
+			} else {
+				result[day] = result[day] || w[day]
+			}
+		}
+	}
+
+	return result
+}
+
+// IsBusinessDay returns true if the date is a business day in every calendar
+// (`JoinHolidays`) or in any calendar (`JoinBusinessDays`).
+func (jc *JointCalendar) IsBusinessDay(d Date) bool {
+	if jc.rule == JoinBusinessDays {
+		for _, c := range jc.calendars {
+			if c.IsBusinessDay(d) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, c := range jc.calendars {
+		if !c.IsBusinessDay(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddBusinessDays returns the date `n` business days after `d` (or before
+// `d` if `n` is negative).
+func (jc *JointCalendar) AddBusinessDays(d Date, n int) Date {
+	return addBusinessDays(jc, d, n)
+}
+
+// NextBusinessDay returns the first business day strictly after `d`.
+func (jc *JointCalendar) NextBusinessDay(d Date) Date {
+	return nextBusinessDay(jc, d)
+}
+
+// PreviousBusinessDay returns the last business day strictly before `d`.
+func (jc *JointCalendar) PreviousBusinessDay(d Date) Date {
+	return previousBusinessDay(jc, d)
+}
+
+// BusinessDaysBetween returns the number of business days in the half-open
+// range `[a, b)`. If `b` is before `a` this is the negative of the number of
+// business days in `[b, a)`.
+//
+// Unlike `Calendar{}.BusinessDaysBetween()`, this visits each date in the
+// range. If either date is infinite (and the dates differ), this saturates to
+// `math.MaxInt64` (or its negative).
+func (jc *JointCalendar) BusinessDaysBetween(a, b Date) int64 {
+	if b.Before(a) {
+		return -jc.BusinessDaysBetween(b, a)
+	}
+	if a.Equal(b) {
+		return 0
+	}
+	if a.IsInfinite() || b.IsInfinite() {
+		return math.MaxInt64
+	}
+
+	count := int64(0)
+	for current := a; current.Before(b); current = current.AddDays(1) {
+		if jc.IsBusinessDay(current) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// SettlementDate returns the settlement date for a trade that settles `n`
+// business days after the trade date (i.e. T+n), where a business day is a
+// date that is a business day in **every** calendar. If no calendars are
+// provided, business days are Monday through Friday.
+//
+// For T+0 (i.e. `n` is 0), a trade date that is not a business day is rolled
+// forward to the next business day.
+func SettlementDate(tradeDate Date, n int, calendars ...BusinessCalendar) Date {
+	if tradeDate.IsInfinite() {
+		return tradeDate
+	}
+
+	if len(calendars) == 0 {
+		calendars = []BusinessCalendar{weekendCalendar()}
+	}
+	jc := &JointCalendar{calendars: calendars, rule: JoinHolidays}
+
+	if n == 0 {
+		return Adjust(tradeDate, jc, Following)
+	}
+
+	return jc.AddBusinessDays(tradeDate, n)
+}
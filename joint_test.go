@@ -0,0 +1,268 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestJointCalendar_IsBusinessDay(base *testing.T) {
+	base.Parallel()
+
+	newYork, london := newYorkAndLondon(base)
+	joinHolidays, err := date.NewJointCalendar(date.JoinHolidays, newYork, london)
+	if err != nil {
+		base.Fatal(err)
+	}
+	joinBusinessDays, err := date.NewJointCalendar(date.JoinBusinessDays, newYork, london)
+	if err != nil {
+		base.Fatal(err)
+	}
+
+	type testCase struct {
+		Date             string
+		JoinHolidays     bool
+		JoinBusinessDays bool
+	}
+
+	cases := []testCase{
+		{Date: "2024-07-02", JoinHolidays: true, JoinBusinessDays: true},
+		{Date: "2024-07-04", JoinHolidays: false, JoinBusinessDays: true},
+		{Date: "2024-08-26", JoinHolidays: false, JoinBusinessDays: true},
+		{Date: "2024-12-25", JoinHolidays: false, JoinBusinessDays: false},
+		{Date: "2024-07-06", JoinHolidays: false, JoinBusinessDays: false},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := mustDate(assert, tc.Date)
+			assert.Equal(tc.JoinHolidays, joinHolidays.IsBusinessDay(d))
+			assert.Equal(tc.JoinBusinessDays, joinBusinessDays.IsBusinessDay(d))
+		})
+	}
+}
+
+func TestJointCalendar_Navigation(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	newYork, london := newYorkAndLondon(t)
+	jc, err := date.NewJointCalendar(date.JoinHolidays, newYork, london)
+	assert.Nil(err)
+
+	// 2024-07-04 is closed in New York and 2024-08-26 is closed in London.
+	assert.Equal("2024-07-05", jc.NextBusinessDay(mustDate(assert, "2024-07-03")).String())
+	assert.Equal("2024-07-03", jc.PreviousBusinessDay(mustDate(assert, "2024-07-05")).String())
+	assert.Equal("2024-08-27", jc.AddBusinessDays(mustDate(assert, "2024-08-23"), 1).String())
+	assert.Equal("2024-08-23", jc.AddBusinessDays(mustDate(assert, "2024-08-27"), -1).String())
+	assert.Equal(int64(3), jc.BusinessDaysBetween(mustDate(assert, "2024-07-01"), mustDate(assert, "2024-07-05")))
+	assert.Equal(int64(-3), jc.BusinessDaysBetween(mustDate(assert, "2024-07-05"), mustDate(assert, "2024-07-01")))
+
+	anyOpen, err := date.NewJointCalendar(date.JoinBusinessDays, newYork, london)
+	assert.Nil(err)
+	assert.Equal("2024-07-04", anyOpen.NextBusinessDay(mustDate(assert, "2024-07-03")).String())
+	assert.Equal(int64(4), anyOpen.BusinessDaysBetween(mustDate(assert, "2024-07-01"), mustDate(assert, "2024-07-05")))
+}
+
+func TestJointCalendar_BusinessDaysBetween_Infinite(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	newYork, london := newYorkAndLondon(t)
+	jc, err := date.NewJointCalendar(date.JoinHolidays, newYork, london)
+	assert.Nil(err)
+
+	d := mustDate(assert, "2024-07-01")
+	assert.Equal(int64(math.MaxInt64), jc.BusinessDaysBetween(d, date.Infinity))
+	assert.Equal(int64(-math.MaxInt64), jc.BusinessDaysBetween(date.Infinity, d))
+	assert.Equal(int64(0), jc.BusinessDaysBetween(date.NegativeInfinity, date.NegativeInfinity))
+}
+
+func TestNewJointCalendar(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	jc, err := date.NewJointCalendar(date.JoinHolidays)
+	assert.Nil(jc)
+	assert.Equal("joint calendar requires at least one calendar", fmt.Sprintf("%v", err))
+
+	c, err := date.NewCalendar()
+	assert.Nil(err)
+	jc, err = date.NewJointCalendar(date.JointRule(7), c)
+	assert.Nil(jc)
+	assert.Equal("invalid joint calendar rule; JointRule(7)", fmt.Sprintf("%v", err))
+
+	// NOTE: Together these weekends cover the entire week.
+	weekdaysOff, err := date.NewCalendar(date.OptCalendarWeekend(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday))
+	assert.Nil(err)
+	jc, err = date.NewJointCalendar(date.JoinHolidays, c, weekdaysOff)
+	assert.Nil(jc)
+	assert.Equal("joint calendar weekend cannot contain every day of the week", fmt.Sprintf("%v", err))
+
+	jc, err = date.NewJointCalendar(date.JoinBusinessDays, c, weekdaysOff)
+	assert.Nil(err)
+	assert.True(jc.IsBusinessDay(mustDate(assert, "2024-07-06")))
+	assert.True(jc.IsBusinessDay(mustDate(assert, "2024-07-08")))
+}
+
+func TestSettlementDate(base *testing.T) {
+	base.Parallel()
+
+	newYork, london := newYorkAndLondon(base)
+
+	type testCase struct {
+		Description string
+		TradeDate   string
+		N           int
+		Calendars   []date.BusinessCalendar
+		Expected    string
+	}
+
+	cases := []testCase{
+		{
+			Description: "Both markets open",
+			TradeDate:   "2024-07-01",
+			N:           2,
+			Calendars:   []date.BusinessCalendar{newYork, london},
+			Expected:    "2024-07-03",
+		},
+		{
+			Description: "New York closed",
+			TradeDate:   "2024-07-03",
+			N:           2,
+			Calendars:   []date.BusinessCalendar{newYork, london},
+			Expected:    "2024-07-08",
+		},
+		{
+			Description: "New York closed, London only",
+			TradeDate:   "2024-07-03",
+			N:           2,
+			Calendars:   []date.BusinessCalendar{london},
+			Expected:    "2024-07-05",
+		},
+		{
+			Description: "London closed",
+			TradeDate:   "2024-08-23",
+			N:           1,
+			Calendars:   []date.BusinessCalendar{newYork, london},
+			Expected:    "2024-08-27",
+		},
+		{
+			Description: "London closed, New York only",
+			TradeDate:   "2024-08-23",
+			N:           1,
+			Calendars:   []date.BusinessCalendar{newYork},
+			Expected:    "2024-08-26",
+		},
+		{
+			Description: "Both markets closed",
+			TradeDate:   "2024-05-24",
+			N:           2,
+			Calendars:   []date.BusinessCalendar{newYork, london},
+			Expected:    "2024-05-29",
+		},
+		{
+			Description: "Different closures",
+			TradeDate:   "2024-12-23",
+			N:           3,
+			Calendars:   []date.BusinessCalendar{newYork, london},
+			Expected:    "2024-12-30",
+		},
+		{
+			Description: "T+0 on a holiday",
+			TradeDate:   "2024-12-25",
+			N:           0,
+			Calendars:   []date.BusinessCalendar{newYork, london},
+			Expected:    "2024-12-27",
+		},
+		{
+			Description: "T+0 on a business day",
+			TradeDate:   "2024-12-27",
+			N:           0,
+			Calendars:   []date.BusinessCalendar{newYork, london},
+			Expected:    "2024-12-27",
+		},
+		{
+			Description: "No calendars",
+			TradeDate:   "2024-07-03",
+			N:           2,
+			Expected:    "2024-07-05",
+		},
+		{
+			Description: "Trade date on a weekend",
+			TradeDate:   "2024-07-06",
+			N:           1,
+			Expected:    "2024-07-08",
+		},
+		{
+			Description: "Infinite",
+			TradeDate:   "infinity",
+			N:           2,
+			Expected:    "infinity",
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			tradeDate := mustDate(assert, tc.TradeDate)
+			settlement := date.SettlementDate(tradeDate, tc.N, tc.Calendars...)
+			assert.Equal(tc.Expected, settlement.String())
+		})
+	}
+}
+
+// newYorkAndLondon returns calendars with the 2024 holidays for New York
+// (US federal holidays) and London (England and Wales bank holidays).
+func newYorkAndLondon(t testing.TB) (*date.Calendar, *date.Calendar) {
+	newYork, err := date.NewCalendar(date.OptCalendarHolidaySets(date.USFederalHolidays()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	london, err := date.NewCalendar(date.OptCalendarHolidays(
+		date.NewDate(2024, time.January, 1),
+		date.NewDate(2024, time.March, 29),
+		date.NewDate(2024, time.April, 1),
+		date.NewDate(2024, time.May, 6),
+		date.NewDate(2024, time.May, 27),
+		date.NewDate(2024, time.August, 26),
+		date.NewDate(2024, time.December, 25),
+		date.NewDate(2024, time.December, 26),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return newYork, london
+}
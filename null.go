@@ -15,17 +15,115 @@
 package date
 
 import (
+	"bytes"
+	"database/sql"
 	"database/sql/driver"
+	"encoding"
+	"encoding/json"
 )
 
-// NullDate is a `Date` that can be null.
+// NOTE: Ensure that
+// - `NullDate` satisfies `encoding.TextMarshaler`.
+// - `NullDate` satisfies `json.Marshaler`.
+// - `*NullDate` satisfies `encoding.TextUnmarshaler`.
+// - `*NullDate` satisfies `json.Unmarshaler`.
+// - `*NullDate` satisfies `sql.Scanner`.
+// - `NullDate` satisfies `driver.Valuer`.
+var (
+	_ encoding.TextMarshaler   = NullDate{}
+	_ json.Marshaler           = NullDate{}
+	_ encoding.TextUnmarshaler = (*NullDate)(nil)
+	_ json.Unmarshaler         = (*NullDate)(nil)
+	_ sql.Scanner              = (*NullDate)(nil)
+	_ driver.Valuer            = NullDate{}
+)
+
+// NullDate is a `Date` that can be null. This is intended to be JSON
+// serialized / deserialized as YYYY-MM-DD or `null`.
 type NullDate struct {
 	Date  Date
 	Valid bool
 }
 
+// Ptr returns a pointer to a copy of the date, or `nil` if the date is null.
+// This is the inverse of `NullDateFromPtr()`.
+func (nd NullDate) Ptr() *Date {
+	if !nd.Valid {
+		return nil
+	}
+
+	d := nd.Date
+	return &d
+}
+
+// ValueOr returns the date, or `fallback` if the date is null.
+func (nd NullDate) ValueOr(fallback Date) Date {
+	if !nd.Valid {
+		return fallback
+	}
+
+	return nd.Date
+}
+
+// MarshalText implements the encoding.TextMarshaler interface; a null date is
+// formatted as an empty string.
+func (nd NullDate) MarshalText() ([]byte, error) {
+	if !nd.Valid {
+		return []byte{}, nil
+	}
+
+	return nd.Date.MarshalText()
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the date as YYYY-MM-DD or
+// `null`.
+func (nd NullDate) MarshalJSON() ([]byte, error) {
+	if !nd.Valid {
+		return []byte("null"), nil
+	}
+
+	return nd.Date.MarshalJSON()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. An empty
+// string is parsed as a null date, otherwise the date must be in the format
+// YYYY-MM-DD.
+func (nd *NullDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*nd = NullDate{}
+		return nil
+	}
+
+	d := Date{}
+	err := d.UnmarshalText(data)
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDate{Date: d, Valid: true}
+	return nil
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`; parses the date as YYYY-MM-DD
+// or `null`.
+func (nd *NullDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*nd = NullDate{}
+		return nil
+	}
+
+	d := Date{}
+	err := d.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDate{Date: d, Valid: true}
+	return nil
+}
+
 // Scan implements `sql.Scanner`; it unmarshals nullable values of the type
-// `time.Time` onto the current `NullDate` struct.
+// `time.Time`, `string` or `[]byte` onto the current `NullDate` struct.
 func (nd *NullDate) Scan(value any) error {
 	if value == nil {
 		nd.Date = Date{}
@@ -43,7 +141,8 @@ func (nd *NullDate) Scan(value any) error {
 }
 
 // Value implements `driver.Valuer`; it marshals the value to a `time.Time`
-// (or `nil`) to be serialized into the database.
+// (or `nil`) to be serialized into the database. For a TEXT column, use
+// `NullTextDate{}` instead.
 func (nd NullDate) Value() (driver.Value, error) {
 	if !nd.Valid {
 		return nil, nil
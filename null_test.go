@@ -15,6 +15,7 @@
 package date_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -48,6 +49,18 @@ func TestNullDate_Scan(t *testing.T) {
 	assert.Nil(err)
 	expected := date.NullDate{Date: date.Date{Year: 1991, Month: time.April, Day: 26}, Valid: true}
 	assert.Equal(expected, nd)
+
+	// Happy path: string
+	nd = date.NullDate{}
+	err = nd.Scan("1991-04-26")
+	assert.Nil(err)
+	assert.Equal(expected, nd)
+
+	// Happy path: bytes
+	nd = date.NullDate{}
+	err = nd.Scan([]byte("1991-04-26 00:00:00"))
+	assert.Nil(err)
+	assert.Equal(expected, nd)
 }
 
 func TestNullDate_Value(t *testing.T) {
@@ -67,3 +80,117 @@ func TestNullDate_Value(t *testing.T) {
 	expected := time.Date(1991, time.April, 26, 0, 0, 0, 0, time.UTC)
 	assert.Equal(expected, v)
 }
+
+func TestNullDate_Ptr(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	nd := date.NullDate{}
+	assert.Nil(nd.Ptr())
+
+	d := date.NewDate(2024, time.January, 1)
+	nd = date.NullDate{Date: d, Valid: true}
+	ptr := nd.Ptr()
+	assert.NotNil(ptr)
+	assert.Equal(d, *ptr)
+	assert.Equal(nd, date.NullDateFromPtr(ptr))
+
+	// Modifying the pointer does not modify the original
+	ptr.Day = 2
+	assert.Equal(d, nd.Date)
+}
+
+func TestNullDate_ValueOr(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(2024, time.January, 1)
+	fallback := date.NewDate(1970, time.January, 1)
+	assert.Equal(fallback, date.NullDate{}.ValueOr(fallback))
+	assert.Equal(d, date.NullDate{Date: d, Valid: true}.ValueOr(fallback))
+}
+
+func TestNullDate_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	type response struct {
+		Closed date.NullDate  `json:"closed"`
+		Opened date.NullDate  `json:"opened"`
+		Other  *date.NullDate `json:"other,omitempty"`
+	}
+
+	r := response{Opened: date.NullDate{Date: date.NewDate(2024, time.January, 1), Valid: true}}
+	asBytes, err := json.Marshal(r)
+	assert.Nil(err)
+	assert.Equal(`{"closed":null,"opened":"2024-01-01"}`, string(asBytes))
+
+	asBytes, err = date.NullDate{}.MarshalText()
+	assert.Nil(err)
+	assert.Equal("", string(asBytes))
+
+	asBytes, err = r.Opened.MarshalText()
+	assert.Nil(err)
+	assert.Equal("2024-01-01", string(asBytes))
+}
+
+func TestNullDate_UnmarshalJSON(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input    []byte
+		NullDate date.NullDate
+		Error    string
+	}
+
+	cases := []testCase{
+		{Input: []byte(`null`), NullDate: date.NullDate{}},
+		{Input: []byte(`"2024-01-01"`), NullDate: date.NullDate{Date: date.NewDate(2024, time.January, 1), Valid: true}},
+		{Input: []byte(`x`), Error: "invalid character 'x' looking for beginning of value"},
+		{Input: []byte(`10`), Error: "json: cannot unmarshal number into Go value of type string"},
+		{Input: []byte(`""`), Error: `parsing time "" as "2006-01-02": cannot parse "" as "2006"`},
+		{Input: []byte(`"2024-02-30"`), Error: `parsing time "2024-02-30": day out of range`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(string(tc.Input), func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			// NOTE: Start from a valid value to ensure `null` resets it.
+			nd := date.NullDate{Date: date.NewDate(1999, time.December, 31), Valid: true}
+			err := json.Unmarshal(tc.Input, &nd)
+			if tc.Error != "" {
+				assert.NotNil(err)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.NullDate, nd)
+		})
+	}
+}
+
+func TestNullDate_UnmarshalText(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	nd := date.NullDate{Date: date.NewDate(1999, time.December, 31), Valid: true}
+	err := nd.UnmarshalText([]byte(""))
+	assert.Nil(err)
+	assert.Equal(date.NullDate{}, nd)
+
+	err = nd.UnmarshalText([]byte("2024-01-01"))
+	assert.Nil(err)
+	assert.Equal(date.NullDate{Date: date.NewDate(2024, time.January, 1), Valid: true}, nd)
+
+	nd = date.NullDate{}
+	err = nd.UnmarshalText([]byte("01/01/2024"))
+	assert.NotNil(err)
+	assert.Equal(`parsing time "01/01/2024" as "2006-01-02": cannot parse "01/01/2024" as "2006"`, fmt.Sprintf("%v", err))
+	assert.Equal(date.NullDate{}, nd)
+}
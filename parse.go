@@ -0,0 +1,125 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// layoutProbeMidnight and layoutProbeAfternoon are the same date at
+	// different times of day in different timezones; a layout formats them
+	// identically if (and only if) it contains only date elements.
+	layoutProbeMidnight  = time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)
+	layoutProbeAfternoon = time.Date(2006, time.January, 2, 13, 14, 15, 123_456_789, time.FixedZone("XYZ", -(7*60*60+30*60)))
+	// layoutProbeOtherDate differs from `layoutProbeMidnight` in every date
+	// element; a layout formats them differently if (and only if) it contains
+	// at least one date element.
+	layoutProbeOtherDate = time.Date(2007, time.March, 4, 0, 0, 0, 0, time.UTC)
+	// weekdayElements are the layout elements for the day of the week, which
+	// `time.Parse()` accepts without checking them against the date.
+	weekdayElements = []string{"Monday", "Mon"}
+)
+
+// Parse parses a date formatted according to a date-only `time` layout, e.g.
+// `01/02/2006`, `Jan 2, 2006` or `20060102`. This uses `time.Parse()` directly
+// and is provided here for convenience. Elements missing from the layout are
+// defaulted as in `time.Parse()`, e.g. the layout `Jan 2006` parses the first
+// of the month.
+//
+// If the layout contains a time of day or time zone element (e.g. `15:04` or
+// `MST`) or does not contain any date elements, an error wrapping
+// `ErrInvalidLayout` is returned. If the value
+// cannot be parsed, the error is a `*ParseError`; if the month or day is out of
+// range (e.g. 02/30/2024), it also matches `ErrInvalidDate`. Unlike
+// `time.Parse()`, a day of the week in the value (e.g. `Monday`) must match
+// the date.
+func Parse(layout, value string) (Date, error) {
+	err := validateLayout(layout)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return parse(layout, value)
+}
+
+// parse parses a date with a layout that is known to contain only date
+// elements.
+func parse(layout, value string) (Date, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return Date{}, newParseError(value, layout, err)
+	}
+
+	err = checkWeekday(layout, value, t)
+	if err != nil {
+		return Date{}, err
+	}
+
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}, nil
+}
+
+// checkWeekday returns a `*ParseError` if the layout contains a day of the
+// week element and the day of the week in the value does not match the
+// parsed date, e.g. `Tuesday, March 4, 2024`.
+func checkWeekday(layout, value string, t time.Time) error {
+	if !strings.Contains(layout, "Mon") {
+		return nil
+	}
+
+	start, ok := elementOffset(value, layout, weekdayElements)
+	if !ok {
+		return nil
+	}
+
+	// NOTE: The first three letters identify a day of the week, whether the
+	//       value contains the full name or the abbreviation.
+	rest := value[start:]
+	expected := t.Weekday().String()
+	if len(rest) >= 3 && strings.EqualFold(rest[:3], expected[:3]) {
+		return nil
+	}
+
+	element := "Mon"
+	if strings.Contains(layout, "Monday") {
+		element = "Monday"
+	}
+	tpe := &time.ParseError{
+		Layout:     layout,
+		Value:      value,
+		LayoutElem: element,
+		ValueElem:  rest,
+		Message:    ": day of week does not match date",
+	}
+	return &ParseError{Input: value, Layout: layout, Offset: start, Err: tpe}
+}
+
+// validateLayout returns an error if a `time` layout contains any elements
+// other than date elements (e.g. an hour, a fractional second, `PM` or a
+// timezone) or does not contain any date elements.
+func validateLayout(layout string) error {
+	formatted := layoutProbeMidnight.Format(layout)
+	if formatted != layoutProbeAfternoon.Format(layout) {
+		return fmt.Errorf("%w; layout=%q", ErrInvalidLayout, layout)
+	}
+	if formatted == layoutProbeOtherDate.Format(layout) {
+		return fmt.Errorf("%w; layout=%q", ErrInvalidLayout, layout)
+	}
+
+	return nil
+}
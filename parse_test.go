@@ -0,0 +1,159 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestParse(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Layout   string
+		Value    string
+		Expected string
+		Error    string
+	}
+
+	cases := []testCase{
+		{Layout: "01/02/2006", Value: "02/29/2024", Expected: "2024-02-29"},
+		{Layout: "Jan 2, 2006", Value: "Feb 29, 2024", Expected: "2024-02-29"},
+		{Layout: "January 2, 2006", Value: "March 1, 2024", Expected: "2024-03-01"},
+		{Layout: "20060102", Value: "20240229", Expected: "2024-02-29"},
+		{Layout: "02-Jan-06", Value: "29-Feb-24", Expected: "2024-02-29"},
+		{Layout: "Monday, 2 January 2006", Value: "Thursday, 29 February 2024", Expected: "2024-02-29"},
+		{Layout: "Mon, Jan 2, 2006", Value: "thu, Feb 29, 2024", Expected: "2024-02-29"},
+		{Layout: "2006-002", Value: "2024-060", Expected: "2024-02-29"},
+		{Layout: "Jan 2006", Value: "Feb 2024", Expected: "2024-02-01"},
+		{Layout: time.DateOnly, Value: "2024-02-29", Expected: "2024-02-29"},
+		{
+			Layout: "01/02/2006",
+			Value:  "Feb 29, 2024",
+			Error:  `parsing time "Feb 29, 2024" as "01/02/2006": cannot parse "Feb 29, 2024" as "01"`,
+		},
+		{
+			Layout: "01/02/2006",
+			Value:  "02/30/2024",
+			Error:  `parsing time "02/30/2024": day out of range`,
+		},
+		{
+			Layout: "Monday, 2 January 2006",
+			Value:  "Friday, 29 February 2024",
+			Error:  `parsing time "Friday, 29 February 2024": day of week does not match date`,
+		},
+		{
+			Layout: "2 Jan 2006 (Mon)",
+			Value:  "29 Feb 2024 (Fri)",
+			Error:  `parsing time "29 Feb 2024 (Fri)": day of week does not match date`,
+		},
+		{
+			Layout: "2006-01-02 15:04",
+			Value:  "2024-02-29 00:00",
+			Error:  `invalid date-only layout; layout="2006-01-02 15:04"`,
+		},
+		{
+			Layout: "01/02/2006 3PM",
+			Value:  "02/29/2024 12AM",
+			Error:  `invalid date-only layout; layout="01/02/2006 3PM"`,
+		},
+		{
+			Layout: time.RFC3339,
+			Value:  "2024-02-29T00:00:00Z",
+			Error:  `invalid date-only layout; layout="2006-01-02T15:04:05Z07:00"`,
+		},
+		{
+			Layout: "2006-01-02 MST",
+			Value:  "2024-02-29 UTC",
+			Error:  `invalid date-only layout; layout="2006-01-02 MST"`,
+		},
+		{
+			Layout: "2006-01-02 -0700",
+			Value:  "2024-02-29 +0000",
+			Error:  `invalid date-only layout; layout="2006-01-02 -0700"`,
+		},
+		{
+			Layout: "2006-01-02.000",
+			Value:  "2024-02-29.000",
+			Error:  `invalid date-only layout; layout="2006-01-02.000"`,
+		},
+		{
+			Layout: "foo",
+			Value:  "foo",
+			Error:  `invalid date-only layout; layout="foo"`,
+		},
+		{
+			Layout: "",
+			Value:  "",
+			Error:  `invalid date-only layout; layout=""`,
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s %s", tc.Layout, tc.Value)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d, err := date.Parse(tc.Layout, tc.Value)
+			if tc.Error != "" {
+				assert.Equal(date.Date{}, d)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Expected, d.String())
+		})
+	}
+}
+
+func TestParse_TypedErrors(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	_, err := date.Parse("15:04 01/02/2006", "00:00 02/29/2024")
+	assert.True(errors.Is(err, date.ErrInvalidLayout))
+
+	_, err = date.Parse("foo", "foo")
+	assert.True(errors.Is(err, date.ErrInvalidLayout))
+
+	_, err = date.Parse("01/02/2006", "02/30/2024")
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+	var pe *date.ParseError
+	assert.True(errors.As(err, &pe))
+	assert.Equal("02/30/2024", pe.Input)
+	assert.Equal("01/02/2006", pe.Layout)
+
+	_, err = date.Parse("01/02/2006", "02/29/24")
+	assert.True(errors.As(err, &pe))
+	assert.Equal(6, pe.Offset)
+	assert.False(errors.Is(err, date.ErrInvalidDate))
+
+	_, err = date.Parse("2 Jan 2006 (Mon)", "29 Feb 2024 (Fri)")
+	assert.True(errors.As(err, &pe))
+	assert.Equal(13, pe.Offset)
+	assert.False(errors.Is(err, date.ErrInvalidDate))
+}
@@ -0,0 +1,328 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DateOrder determines whether numeric dates such as `03/04/2024` are
+// preferred as month first or day first when parsing with a `Parser`.
+type DateOrder int
+
+const (
+	// MonthFirst prefers month first numeric dates (e.g. the US convention,
+	// `03/04/2024` is March 4).
+	MonthFirst DateOrder = iota
+	// DayFirst prefers day first numeric dates (e.g. the European convention,
+	// `03/04/2024` is April 3).
+	DayFirst
+)
+
+var (
+	isoLayouts = []string{
+		"2006-01-02",
+		"2006/01/02",
+		"20060102",
+	}
+	monthFirstLayouts = []string{
+		"1/2/2006",
+		"1-2-2006",
+		"1/2/06",
+	}
+	dayFirstLayouts = []string{
+		"2/1/2006",
+		"2-1-2006",
+		"2.1.2006",
+		"2/1/06",
+		"2.1.06",
+	}
+	monthNameLayouts = []string{
+		"Jan 2, 2006",
+		"January 2, 2006",
+		"Jan 2 2006",
+		"January 2 2006",
+		"2 Jan 2006",
+		"2 January 2006",
+		"2-Jan-2006",
+		"2-Jan-06",
+		"Mon, Jan 2, 2006",
+		"Monday, January 2, 2006",
+	}
+)
+
+// DefaultParserLayouts returns the layouts used by a `Parser`, in priority
+// order: ISO (e.g. `2024-03-04` and `20240304`), numeric dates in the preferred
+// order, numeric dates in the other order and finally dates with month names
+// (e.g. `Mar 4, 2024` and `4-Mar-24`).
+func DefaultParserLayouts(order DateOrder) []string {
+	first, second := monthFirstLayouts, dayFirstLayouts
+	if order == DayFirst {
+		first, second = dayFirstLayouts, monthFirstLayouts
+	}
+
+	layouts := make([]string, 0, len(isoLayouts)+len(first)+len(second)+len(monthNameLayouts))
+	layouts = append(layouts, isoLayouts...)
+	layouts = append(layouts, first...)
+	layouts = append(layouts, second...)
+	layouts = append(layouts, monthNameLayouts...)
+	return layouts
+}
+
+// ParserConfig helps customize the behavior of `NewParser()`.
+type ParserConfig struct {
+	Order           DateOrder
+	Layouts         []string
+	RejectAmbiguous bool
+}
+
+// ParserOption defines a function that will be applied to a parser config.
+type ParserOption func(*ParserConfig)
+
+// OptParserOrder returns an option that sets the preferred order of numeric
+// dates on a parser config. This only applies to the default layouts.
+func OptParserOrder(order DateOrder) ParserOption {
+	return func(pc *ParserConfig) {
+		pc.Order = order
+	}
+}
+
+// OptParserLayouts returns an option that sets the layouts (in priority order)
+// on a parser config. This replaces the default layouts.
+func OptParserLayouts(layouts ...string) ParserOption {
+	return func(pc *ParserConfig) {
+		pc.Layouts = append([]string{}, layouts...)
+	}
+}
+
+// OptParserRejectAmbiguous returns an option that configures a parser to
+// return an error for ambiguous inputs rather than flagging them.
+func OptParserRejectAmbiguous() ParserOption {
+	return func(pc *ParserConfig) {
+		pc.RejectAmbiguous = true
+	}
+}
+
+// Parser is a lenient parser that tries a prioritized list of date-only
+// layouts. An input is ambiguous if two layouts parse it as different dates,
+// e.g. `03/04/2024` as either March 4 or April 3. By default the highest
+// priority layout is used and the result is flagged as ambiguous.
+type Parser struct {
+	layouts         []string
+	rejectAmbiguous bool
+}
+
+// ParseResult is the result of parsing a single value with a `Parser`.
+type ParseResult struct {
+	Date Date
+	// Layout is the (highest priority) layout that matched.
+	Layout string
+	// Ambiguous indicates that a lower priority layout also matched the value
+	// but produced a different date.
+	Ambiguous bool
+}
+
+// ColumnResult is the result of parsing a column of values with a `Parser`.
+type ColumnResult struct {
+	// Dates contains the parsed values, in order; blank values are null.
+	Dates []NullDate
+	// Layout is the (highest priority) layout that matched every value. This
+	// is empty if every value is blank.
+	Layout string
+	// Ambiguous indicates that a lower priority layout also matched every
+	// value but produced a different date for at least one of them.
+	Ambiguous bool
+}
+
+// NewParser returns a new `Parser`. By default, the layouts are
+// `DefaultParserLayouts(MonthFirst)`.
+//
+// An error is returned if there are no layouts or if a layout contains a time
+// of day or time zone element.
+func NewParser(opts ...ParserOption) (*Parser, error) {
+	pc := ParserConfig{Order: MonthFirst}
+	for _, opt := range opts {
+		opt(&pc)
+	}
+
+	layouts := pc.Layouts
+	if layouts == nil {
+		layouts = DefaultParserLayouts(pc.Order)
+	}
+	if len(layouts) == 0 {
+		return nil, fmt.Errorf("parser requires at least one layout")
+	}
+
+	for _, layout := range layouts {
+		err := validateLayout(layout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p := &Parser{
+		layouts:         layouts,
+		rejectAmbiguous: pc.RejectAmbiguous,
+	}
+	return p, nil
+}
+
+// Parse parses a single value (ignoring surrounding whitespace) with the
+// highest priority matching layout.
+//
+// If no layout matches, an error wrapping `ErrUnrecognizedDate` is returned.
+// If the parser rejects ambiguous inputs and another layout matches with a
+// different date, an error wrapping `ErrAmbiguousDate` is returned.
+func (p *Parser) Parse(value string) (ParseResult, error) {
+	trimmed := strings.TrimSpace(value)
+
+	result := ParseResult{}
+	found := false
+	for _, layout := range p.layouts {
+		d, err := parse(layout, trimmed)
+		if err != nil {
+			continue
+		}
+
+		if !found {
+			result = ParseResult{Date: d, Layout: layout}
+			found = true
+			continue
+		}
+
+		if d != result.Date {
+			if p.rejectAmbiguous {
+				return ParseResult{}, fmt.Errorf("%w; %q matches layouts %q and %q", ErrAmbiguousDate, value, result.Layout, layout)
+			}
+			result.Ambiguous = true
+			break
+		}
+	}
+
+	if !found {
+		return ParseResult{}, fmt.Errorf("%w; %q", ErrUnrecognizedDate, value)
+	}
+
+	return result, nil
+}
+
+// ParseColumn parses a column of values (e.g. from a CSV file) with a single
+// consistent layout: the highest priority layout that matches every value.
+// Blank values are parsed as null.
+//
+// If no single layout matches every value, an error wrapping
+// `ErrUnrecognizedDate` is returned. If the parser rejects ambiguous inputs and
+// another layout matches every value with a different date for some value, an
+// error wrapping `ErrAmbiguousDate` is returned.
+func (p *Parser) ParseColumn(values []string) (ColumnResult, error) {
+	result := ColumnResult{Dates: make([]NullDate, len(values))}
+	if allBlank(values) {
+		return result, nil
+	}
+
+	var chosen []Date
+	for _, layout := range p.layouts {
+		dates, ok := parseAll(layout, values)
+		if !ok {
+			continue
+		}
+
+		if chosen == nil {
+			chosen = dates
+			result.Layout = layout
+			continue
+		}
+
+		if !equalDates(chosen, dates) {
+			if p.rejectAmbiguous {
+				return ColumnResult{}, fmt.Errorf("%w; values match layouts %q and %q", ErrAmbiguousDate, result.Layout, layout)
+			}
+			result.Ambiguous = true
+			break
+		}
+	}
+
+	if chosen == nil {
+		for _, value := range values {
+			trimmed := strings.TrimSpace(value)
+			if trimmed != "" && !p.matchesAny(trimmed) {
+				return ColumnResult{}, fmt.Errorf("%w; %q", ErrUnrecognizedDate, value)
+			}
+		}
+		return ColumnResult{}, fmt.Errorf("%w; no single layout matches every value", ErrUnrecognizedDate)
+	}
+
+	for i, value := range values {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		result.Dates[i] = NullDate{Date: chosen[i], Valid: true}
+	}
+
+	return result, nil
+}
+
+// matchesAny returns true if any layout matches the (trimmed) value.
+func (p *Parser) matchesAny(trimmed string) bool {
+	for _, layout := range p.layouts {
+		_, err := parse(layout, trimmed)
+		if err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseAll parses every non-blank value with a single layout. The returned
+// slice is aligned with `values` (blank values are left as the zero date).
+func parseAll(layout string, values []string) ([]Date, bool) {
+	dates := make([]Date, len(values))
+	for i, value := range values {
+		trimmed := strings.TrimSpace(value)
+		if trimmed == "" {
+			continue
+		}
+
+		d, err := parse(layout, trimmed)
+		if err != nil {
+			return nil, false
+		}
+		dates[i] = d
+	}
+
+	return dates, true
+}
+
+func allBlank(values []string) bool {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalDates(a, b []Date) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,245 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestParser_Parse(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Order     date.DateOrder
+		Value     string
+		Expected  string
+		Layout    string
+		Ambiguous bool
+		Error     string
+	}
+
+	cases := []testCase{
+		{Value: "2024-03-04", Expected: "2024-03-04", Layout: "2006-01-02"},
+		{Value: " 2024/03/04 ", Expected: "2024-03-04", Layout: "2006/01/02"},
+		{Value: "20240304", Expected: "2024-03-04", Layout: "20060102"},
+		{Value: "03/04/2024", Expected: "2024-03-04", Layout: "1/2/2006", Ambiguous: true},
+		{Order: date.DayFirst, Value: "03/04/2024", Expected: "2024-04-03", Layout: "2/1/2006", Ambiguous: true},
+		{Value: "3/14/2024", Expected: "2024-03-14", Layout: "1/2/2006"},
+		{Order: date.DayFirst, Value: "3/14/2024", Expected: "2024-03-14", Layout: "1/2/2006"},
+		{Value: "14/03/2024", Expected: "2024-03-14", Layout: "2/1/2006"},
+		{Value: "04/04/2024", Expected: "2024-04-04", Layout: "1/2/2006"},
+		{Value: "14.03.2024", Expected: "2024-03-14", Layout: "2.1.2006"},
+		{Value: "3/14/24", Expected: "2024-03-14", Layout: "1/2/06"},
+		{Value: "Mar 4, 2024", Expected: "2024-03-04", Layout: "Jan 2, 2006"},
+		{Value: "march 4, 2024", Expected: "2024-03-04", Layout: "January 2, 2006"},
+		{Value: "4 March 2024", Expected: "2024-03-04", Layout: "2 January 2006"},
+		{Value: "04-Mar-24", Expected: "2024-03-04", Layout: "2-Jan-06"},
+		{Value: "Monday, March 4, 2024", Expected: "2024-03-04", Layout: "Monday, January 2, 2006"},
+		{Value: "Mon, Mar 4, 2024", Expected: "2024-03-04", Layout: "Mon, Jan 2, 2006"},
+		{Value: "Tuesday, March 4, 2024", Error: `date does not match any layout; "Tuesday, March 4, 2024"`},
+		{Value: "2024-02-30", Error: `date does not match any layout; "2024-02-30"`},
+		{Value: "next tuesday", Error: `date does not match any layout; "next tuesday"`},
+		{Value: "", Error: `date does not match any layout; ""`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%d %q", tc.Order, tc.Value)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			p, err := date.NewParser(date.OptParserOrder(tc.Order))
+			assert.Nil(err)
+
+			result, err := p.Parse(tc.Value)
+			if tc.Error != "" {
+				assert.Equal(date.ParseResult{}, result)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				assert.True(errors.Is(err, date.ErrUnrecognizedDate))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Expected, result.Date.String())
+			assert.Equal(tc.Layout, result.Layout)
+			assert.Equal(tc.Ambiguous, result.Ambiguous)
+		})
+	}
+}
+
+func TestParser_RejectAmbiguous(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	p, err := date.NewParser(date.OptParserOrder(date.DayFirst), date.OptParserRejectAmbiguous())
+	assert.Nil(err)
+
+	result, err := p.Parse("03/04/2024")
+	assert.Equal(date.ParseResult{}, result)
+	assert.True(errors.Is(err, date.ErrAmbiguousDate))
+	assert.Equal(`ambiguous date; "03/04/2024" matches layouts "2/1/2006" and "1/2/2006"`, fmt.Sprintf("%v", err))
+
+	result, err = p.Parse("13/04/2024")
+	assert.Nil(err)
+	assert.Equal(date.ParseResult{Date: date.NewDate(2024, 4, 13), Layout: "2/1/2006"}, result)
+
+	result, err = p.Parse("04/04/2024")
+	assert.Nil(err)
+	assert.Equal(date.ParseResult{Date: date.NewDate(2024, 4, 4), Layout: "2/1/2006"}, result)
+}
+
+func TestNewParser(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	p, err := date.NewParser(date.OptParserLayouts("02 Jan 06", "2006.01.02"))
+	assert.Nil(err)
+	result, err := p.Parse("2024.03.04")
+	assert.Nil(err)
+	assert.Equal(date.ParseResult{Date: date.NewDate(2024, 3, 4), Layout: "2006.01.02"}, result)
+	_, err = p.Parse("2024-03-04")
+	assert.True(errors.Is(err, date.ErrUnrecognizedDate))
+
+	p, err = date.NewParser(date.OptParserLayouts())
+	assert.Nil(p)
+	assert.Equal("parser requires at least one layout", fmt.Sprintf("%v", err))
+
+	p, err = date.NewParser(date.OptParserLayouts("2006-01-02", "2006-01-02 15:04"))
+	assert.Nil(p)
+	assert.True(errors.Is(err, date.ErrInvalidLayout))
+	assert.Equal(`invalid date-only layout; layout="2006-01-02 15:04"`, fmt.Sprintf("%v", err))
+}
+
+func TestParser_ParseColumn(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Description string
+		Values      []string
+		Expected    []string
+		Layout      string
+		Ambiguous   bool
+		Error       string
+	}
+
+	cases := []testCase{
+		{
+			Description: "Unambiguous day first",
+			Values:      []string{"03/04/2024", "", "13/04/2024"},
+			Expected:    []string{"2024-04-03", "", "2024-04-13"},
+			Layout:      "2/1/2006",
+		},
+		{
+			Description: "Unambiguous month first",
+			Values:      []string{"03/04/2024", "03/14/2024"},
+			Expected:    []string{"2024-03-04", "2024-03-14"},
+			Layout:      "1/2/2006",
+		},
+		{
+			Description: "Ambiguous",
+			Values:      []string{"03/04/2024", "05/06/2024"},
+			Expected:    []string{"2024-03-04", "2024-05-06"},
+			Layout:      "1/2/2006",
+			Ambiguous:   true,
+		},
+		{
+			Description: "Same date in every layout",
+			Values:      []string{"04/04/2024", "05/05/2024"},
+			Expected:    []string{"2024-04-04", "2024-05-05"},
+			Layout:      "1/2/2006",
+		},
+		{
+			Description: "ISO",
+			Values:      []string{"2024-03-04", " 2024-12-31 "},
+			Expected:    []string{"2024-03-04", "2024-12-31"},
+			Layout:      "2006-01-02",
+		},
+		{
+			Description: "All blank",
+			Values:      []string{"", " "},
+			Expected:    []string{"", ""},
+		},
+		{
+			Description: "Inconsistent layouts",
+			Values:      []string{"03/13/2024", "13/03/2024"},
+			Error:       "date does not match any layout; no single layout matches every value",
+		},
+		{
+			Description: "Unrecognized value",
+			Values:      []string{"03/13/2024", "soon"},
+			Error:       `date does not match any layout; "soon"`,
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			p, err := date.NewParser()
+			assert.Nil(err)
+
+			result, err := p.ParseColumn(tc.Values)
+			if tc.Error != "" {
+				assert.Equal(date.ColumnResult{}, result)
+				assert.True(errors.Is(err, date.ErrUnrecognizedDate))
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Layout, result.Layout)
+			assert.Equal(tc.Ambiguous, result.Ambiguous)
+			actual := make([]string, len(result.Dates))
+			for j, nd := range result.Dates {
+				if nd.Valid {
+					actual[j] = nd.Date.String()
+				}
+			}
+			assert.Equal(tc.Expected, actual)
+		})
+	}
+}
+
+func TestParser_ParseColumn_RejectAmbiguous(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	p, err := date.NewParser(date.OptParserRejectAmbiguous())
+	assert.Nil(err)
+
+	result, err := p.ParseColumn([]string{"03/04/2024", "05/06/2024"})
+	assert.Equal(date.ColumnResult{}, result)
+	assert.True(errors.Is(err, date.ErrAmbiguousDate))
+	assert.Equal(`ambiguous date; values match layouts "1/2/2006" and "2/1/2006"`, fmt.Sprintf("%v", err))
+
+	// NOTE: A single unambiguous value determines the layout for the column.
+	result, err = p.ParseColumn([]string{"03/04/2024", "05/13/2024"})
+	assert.Nil(err)
+	assert.Equal("1/2/2006", result.Layout)
+	assert.Equal(date.NullDate{Date: date.NewDate(2024, 3, 4), Valid: true}, result.Dates[0])
+}
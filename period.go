@@ -0,0 +1,350 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NOTE: Ensure that
+// - `Period` satisfies `fmt.Stringer`.
+// - `Period` satisfies `encoding.TextMarshaler`.
+// - `Period` satisfies `json.Marshaler`.
+// - `*Period` satisfies `encoding.TextUnmarshaler`.
+// - `*Period` satisfies `json.Unmarshaler`.
+// - `*Period` satisfies `sql.Scanner`.
+// - `Period` satisfies `driver.Valuer`.
+var (
+	_ fmt.Stringer             = Period{}
+	_ encoding.TextMarshaler   = Period{}
+	_ json.Marshaler           = Period{}
+	_ encoding.TextUnmarshaler = (*Period)(nil)
+	_ json.Unmarshaler         = (*Period)(nil)
+	_ sql.Scanner              = (*Period)(nil)
+	_ driver.Valuer            = Period{}
+)
+
+// Period is an amount of calendar time in years, months, weeks, and days
+// (e.g. "3 months"). This is intended to be serialized as an ISO 8601
+// duration restricted to date components, e.g. P1Y2M10D.
+//
+// Unlike a `time.Duration`, a period does not correspond to a fixed number of
+// days; e.g. adding 1 month to a date may add anywhere from 28 to 31 days.
+type Period struct {
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+}
+
+// NewPeriod returns a new `Period` struct. This is a pure convenience function
+// to make it more ergonomic to create a `Period` struct.
+func NewPeriod(years, months, weeks, days int) Period {
+	return Period{Years: years, Months: months, Weeks: weeks, Days: days}
+}
+
+// IsZero returns true if the period is the zero value.
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Weeks == 0 && p.Days == 0
+}
+
+// Negate returns the period with every component negated.
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days}
+}
+
+// TotalMonths returns the years and months in the period as a number of
+// months.
+func (p Period) TotalMonths() int {
+	return 12*p.Years + p.Months
+}
+
+// TotalDays returns the weeks and days in the period as a number of days.
+func (p Period) TotalDays() int {
+	return 7*p.Weeks + p.Days
+}
+
+// AddPeriod returns the date corresponding to adding the given period. The
+// years and months are added first (via `AddMonths()`, so the day is clamped
+// to the end of a shorter target month) and then the weeks and days are
+// added.
+//
+// For example:
+// - adding P1M to 2022-01-31 results in 2022-02-28
+// - adding P1M1D to 2022-01-31 results in 2022-03-01
+// - adding P1Y to 2020-02-29 results in 2021-02-28
+func (d Date) AddPeriod(p Period) Date {
+	return d.AddMonths(p.TotalMonths()).AddDays(p.TotalDays())
+}
+
+// AddPeriodStdlib returns the date corresponding to adding the given period,
+// using `time.Time{}.AddDate()` from the standard library. This may
+// "overshoot" if the target date is not a valid date in that month, e.g.
+// 2020-02-31.
+//
+// For example:
+// - adding P1M to 2022-01-31 results in 2022-03-03
+// - adding P1M1D to 2022-01-31 results in 2022-03-04
+// - adding P1Y to 2020-02-29 results in 2021-03-01
+func (d Date) AddPeriodStdlib(p Period) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	t := d.ToTime().AddDate(p.Years, p.Months, p.TotalDays())
+	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+}
+
+// Between returns the calendar period from `start` to `end` as a number of
+// years, months, and days (weeks are always 0). The result is normalized so
+// that months are in the range (-12, 12) and all components have the same
+// sign.
+//
+// This is consistent with the clamping behavior of `AddMonths()`; adding the
+// result to `start` via `AddPeriod()` always lands exactly on `end`. For
+// example:
+// - between 2020-05-11 and 2021-07-21 is P1Y2M10D
+// - between 2023-01-31 and 2023-02-28 is P1M
+// - between 2023-01-31 and 2023-03-01 is P1M1D
+// - between 2021-07-21 and 2020-05-11 is -P1Y2M10D
+//
+// If either date is infinite, an error wrapping `ErrSubInfinite` is returned.
+func Between(start, end Date) (Period, error) {
+	if start.IsInfinite() || end.IsInfinite() {
+		return Period{}, fmt.Errorf("%w; start=%s, end=%s", ErrSubInfinite, start, end)
+	}
+
+	months := MonthsBetween(start, end)
+	days := end.Sub(start.AddMonths(months))
+	return Period{Years: months / 12, Months: months % 12, Days: int(days)}, nil
+}
+
+// MonthsBetween returns the number of whole months from `start` to `end`,
+// i.e. the largest number of months that can be added to `start` (via
+// `AddMonths()`) without passing `end`. If `end` is before `start`, this is
+// negative. If either date is infinite (and the dates differ), this saturates
+// to `math.MaxInt` (or `math.MinInt`).
+func MonthsBetween(start, end Date) int {
+	if start.IsInfinite() || end.IsInfinite() {
+		switch {
+		case start.Equal(end):
+			return 0
+		case start.Before(end):
+			return math.MaxInt
+		default:
+			return math.MinInt
+		}
+	}
+
+	months := 12*(end.Year-start.Year) + int(end.Month-start.Month)
+	if end.Before(start) {
+		if start.AddMonths(months).Before(end) {
+			months++
+		}
+		return months
+	}
+
+	if end.Before(start.AddMonths(months)) {
+		months--
+	}
+	return months
+}
+
+// YearsBetween returns the number of whole years from `start` to `end`, i.e.
+// the largest number of years that can be added to `start` (via
+// `AddYears()`) without passing `end`. If `end` is before `start`, this is
+// negative. This can be used to compute an age, e.g. the age of an account.
+// If either date is infinite (and the dates differ), this saturates to
+// `math.MaxInt` (or `math.MinInt`).
+func YearsBetween(start, end Date) int {
+	months := MonthsBetween(start, end)
+	if months == math.MaxInt || months == math.MinInt {
+		return months
+	}
+
+	return months / 12
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the period as an ISO 8601
+// duration.
+func (p Period) MarshalJSON() ([]byte, error) {
+	s := p.String()
+	return json.Marshal(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The period
+// must be an ISO 8601 duration with only date components.
+func (p *Period) UnmarshalText(data []byte) error {
+	parsed, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`; parses the period as an ISO
+// 8601 duration with only date components.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	s := ""
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParsePeriod(s)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// Scan implements `sql.Scanner`; it unmarshals values of the type `string` or
+// `[]byte` onto the current `Period` struct.
+func (p *Period) Scan(src any) error {
+	var s string
+
+	switch srcTyped := src.(type) {
+	case string:
+		s = srcTyped
+	case []byte:
+		s = string(srcTyped)
+	default:
+		return fmt.Errorf("incompatible type for Period; type=%T", src)
+	}
+
+	parsed, err := ParsePeriod(s)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// Value implements `driver.Valuer`; it marshals the value to an ISO 8601
+// duration string to be serialized into the database.
+func (p Period) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// String implements `fmt.Stringer`; formats the period as an ISO 8601
+// duration, e.g. P1Y2M10D. The zero period is formatted as P0D.
+//
+// If every non-zero component is negative, the period is formatted with a
+// leading minus sign, e.g. -P1Y2M. Otherwise, negative components are
+// formatted with their own sign, e.g. P1Y-2M.
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	sb := strings.Builder{}
+	q := p
+	if p.Years <= 0 && p.Months <= 0 && p.Weeks <= 0 && p.Days <= 0 {
+		sb.WriteString("-")
+		q = p.Negate()
+	}
+
+	sb.WriteString("P")
+	writeComponent(&sb, q.Years, 'Y')
+	writeComponent(&sb, q.Months, 'M')
+	writeComponent(&sb, q.Weeks, 'W')
+	writeComponent(&sb, q.Days, 'D')
+	return sb.String()
+}
+
+func writeComponent(sb *strings.Builder, value int, designator byte) {
+	if value == 0 {
+		return
+	}
+
+	sb.WriteString(strconv.Itoa(value))
+	sb.WriteByte(designator)
+}
+
+// ParsePeriod parses an ISO 8601 duration with only date components (years,
+// months, weeks, and days) into a `Period{}`, e.g. P1Y2M10D or P3M.
+//
+// A leading sign negates the entire period (e.g. -P1M) and individual
+// components may also be signed (e.g. P1Y-2M). Components must appear in
+// the order Y, M, W, D and time components (e.g. PT1H) are not supported.
+func ParsePeriod(s string) (Period, error) {
+	rest := s
+	negate := false
+	if strings.HasPrefix(rest, "-") {
+		negate = true
+		rest = rest[1:]
+	} else if strings.HasPrefix(rest, "+") {
+		rest = rest[1:]
+	}
+
+	if !strings.HasPrefix(rest, "P") || len(rest) == 1 {
+		return Period{}, fmt.Errorf("invalid period; %q", s)
+	}
+	rest = rest[1:]
+
+	p := Period{}
+	designators := "YMWD"
+	for rest != "" {
+		end := strings.IndexAny(rest, designators)
+		if end <= 0 {
+			return Period{}, fmt.Errorf("invalid period; %q", s)
+		}
+
+		value, err := strconv.Atoi(rest[:end])
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period; %q", s)
+		}
+
+		designator := rest[end]
+		switch designator {
+		case 'Y':
+			p.Years = value
+		case 'M':
+			p.Months = value
+		case 'W':
+			p.Weeks = value
+		case 'D':
+			p.Days = value
+		}
+
+		// NOTE: Only allow designators that come **after** this one, which
+		//       enforces ordering and prevents repeated components.
+		designators = designators[strings.IndexByte(designators, designator)+1:]
+		rest = rest[end+1:]
+	}
+
+	if negate {
+		return p.Negate(), nil
+	}
+
+	return p, nil
+}
@@ -0,0 +1,350 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestNewPeriod(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	p := date.NewPeriod(1, 2, 3, 4)
+	expected := date.Period{Years: 1, Months: 2, Weeks: 3, Days: 4}
+	assert.Equal(expected, p)
+	assert.Equal(14, p.TotalMonths())
+	assert.Equal(25, p.TotalDays())
+	assert.False(p.IsZero())
+	assert.True(date.Period{}.IsZero())
+	assert.Equal(date.NewPeriod(-1, -2, -3, -4), p.Negate())
+}
+
+func TestParsePeriod(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input    string
+		Period   date.Period
+		Expected string
+		Error    string
+	}
+
+	cases := []testCase{
+		{Input: "P0D", Period: date.Period{}, Expected: "P0D"},
+		{Input: "P1Y2M10D", Period: date.NewPeriod(1, 2, 0, 10), Expected: "P1Y2M10D"},
+		{Input: "P3M", Period: date.NewPeriod(0, 3, 0, 0), Expected: "P3M"},
+		{Input: "P2W", Period: date.NewPeriod(0, 0, 2, 0), Expected: "P2W"},
+		{Input: "P1Y2M3W4D", Period: date.NewPeriod(1, 2, 3, 4), Expected: "P1Y2M3W4D"},
+		{Input: "P18M", Period: date.NewPeriod(0, 18, 0, 0), Expected: "P18M"},
+		{Input: "+P1Y", Period: date.NewPeriod(1, 0, 0, 0), Expected: "P1Y"},
+		{Input: "-P1Y2M", Period: date.NewPeriod(-1, -2, 0, 0), Expected: "-P1Y2M"},
+		{Input: "P-1Y-2M", Period: date.NewPeriod(-1, -2, 0, 0), Expected: "-P1Y2M"},
+		{Input: "P1Y-2M", Period: date.NewPeriod(1, -2, 0, 0), Expected: "P1Y-2M"},
+		{Input: "-P1Y-2M", Period: date.NewPeriod(-1, 2, 0, 0), Expected: "P-1Y2M"},
+		{Input: "", Error: `invalid period; ""`},
+		{Input: "P", Error: `invalid period; "P"`},
+		{Input: "-P", Error: `invalid period; "-P"`},
+		{Input: "1Y", Error: `invalid period; "1Y"`},
+		{Input: "PY", Error: `invalid period; "PY"`},
+		{Input: "P1", Error: `invalid period; "P1"`},
+		{Input: "P1M1Y", Error: `invalid period; "P1M1Y"`},
+		{Input: "P1M1M", Error: `invalid period; "P1M1M"`},
+		{Input: "PT1H", Error: `invalid period; "PT1H"`},
+		{Input: "P1DT1H", Error: `invalid period; "P1DT1H"`},
+		{Input: "P1.5Y", Error: `invalid period; "P1.5Y"`},
+		{Input: "P99999999999999999999D", Error: `invalid period; "P99999999999999999999D"`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			p, err := date.ParsePeriod(tc.Input)
+			if tc.Error != "" {
+				assert.NotNil(err)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				assert.Equal(date.Period{}, p)
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Period, p)
+			assert.Equal(tc.Expected, p.String())
+		})
+	}
+}
+
+func TestDate_AddPeriod(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date     string
+		Period   string
+		Expected string
+		Contrast string
+	}
+
+	cases := []testCase{
+		{Date: "2020-05-11", Period: "P0D", Expected: "2020-05-11", Contrast: "2020-05-11"},
+		{Date: "2020-05-11", Period: "P1Y2M10D", Expected: "2021-07-21", Contrast: "2021-07-21"},
+		{Date: "2022-01-31", Period: "P1M", Expected: "2022-02-28", Contrast: "2022-03-03"},
+		{Date: "2022-01-31", Period: "P1M1D", Expected: "2022-03-01", Contrast: "2022-03-04"},
+		{Date: "2020-02-29", Period: "P1Y", Expected: "2021-02-28", Contrast: "2021-03-01"},
+		{Date: "2024-01-31", Period: "P3M", Expected: "2024-04-30", Contrast: "2024-05-01"},
+		{Date: "2024-01-31", Period: "P2W", Expected: "2024-02-14", Contrast: "2024-02-14"},
+		{Date: "2022-01-31", Period: "-P2M", Expected: "2021-11-30", Contrast: "2021-12-01"},
+		{Date: "2022-03-31", Period: "P-1M1D", Expected: "2022-03-01", Contrast: "2022-03-04"},
+		{Date: "infinity", Period: "P1D", Expected: "infinity", Contrast: "infinity"},
+		{Date: "-infinity", Period: "-P1Y1M1D", Expected: "-infinity", Contrast: "-infinity"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s + %s -> %s", tc.Date, tc.Period, tc.Expected)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := mustDate(assert, tc.Date)
+			p, err := date.ParsePeriod(tc.Period)
+			assert.Nil(err)
+
+			assert.Equal(tc.Expected, d.AddPeriod(p).String())
+			assert.Equal(tc.Contrast, d.AddPeriodStdlib(p).String())
+		})
+	}
+}
+
+func TestPeriod_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	type config struct {
+		Tenor date.Period `json:"tenor"`
+	}
+
+	asBytes, err := json.Marshal(config{Tenor: date.NewPeriod(0, 3, 0, 0)})
+	assert.Nil(err)
+	assert.Equal(`{"tenor":"P3M"}`, string(asBytes))
+
+	asBytes, err = date.NewPeriod(1, 0, 0, 0).MarshalText()
+	assert.Nil(err)
+	assert.Equal("P1Y", string(asBytes))
+}
+
+func TestPeriod_UnmarshalJSON(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input  []byte
+		Period date.Period
+		Error  string
+	}
+
+	cases := []testCase{
+		{Input: []byte(`x`), Error: "invalid character 'x' looking for beginning of value"},
+		{Input: []byte(`10`), Error: "json: cannot unmarshal number into Go value of type string"},
+		{Input: []byte(`"3M"`), Error: `invalid period; "3M"`},
+		{Input: []byte(`"P3M"`), Period: date.NewPeriod(0, 3, 0, 0)},
+		{Input: []byte(`"P1Y2M3W4D"`), Period: date.NewPeriod(1, 2, 3, 4)},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(string(tc.Input), func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			p := date.Period{}
+			err := json.Unmarshal(tc.Input, &p)
+			if err != nil {
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				assert.Equal(date.Period{}, p)
+			} else {
+				assert.Equal("", tc.Error)
+				assert.Equal(tc.Period, p)
+			}
+		})
+	}
+}
+
+func TestPeriod_UnmarshalText(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	p := date.Period{}
+	err := p.UnmarshalText([]byte("P6M"))
+	assert.Nil(err)
+	assert.Equal(date.NewPeriod(0, 6, 0, 0), p)
+
+	p = date.Period{}
+	err = p.UnmarshalText([]byte("6M"))
+	assert.NotNil(err)
+	assert.Equal(`invalid period; "6M"`, fmt.Sprintf("%v", err))
+	assert.Equal(date.Period{}, p)
+}
+
+func TestPeriod_Scan(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	// Wrong type
+	p := date.Period{}
+	err := p.Scan(1)
+	assert.NotNil(err)
+	assert.Equal("incompatible type for Period; type=int", fmt.Sprintf("%v", err))
+	assert.Equal(date.Period{}, p)
+
+	// Invalid
+	err = p.Scan("1 mon")
+	assert.NotNil(err)
+	assert.Equal(`invalid period; "1 mon"`, fmt.Sprintf("%v", err))
+	assert.Equal(date.Period{}, p)
+
+	// Happy path: string
+	err = p.Scan("P1M")
+	assert.Nil(err)
+	assert.Equal(date.NewPeriod(0, 1, 0, 0), p)
+
+	// Happy path: bytes
+	err = p.Scan([]byte("P2W"))
+	assert.Nil(err)
+	assert.Equal(date.NewPeriod(0, 0, 2, 0), p)
+}
+
+func TestPeriod_Value(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	v, err := date.NewPeriod(0, 18, 0, 0).Value()
+	assert.Nil(err)
+	assert.Equal("P18M", v)
+}
+
+func TestBetween(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Start    string
+		End      string
+		Expected string
+		Months   int
+		Years    int
+	}
+
+	cases := []testCase{
+		{Start: "2020-05-11", End: "2020-05-11", Expected: "P0D", Months: 0, Years: 0},
+		{Start: "2020-05-11", End: "2021-07-21", Expected: "P1Y2M10D", Months: 14, Years: 1},
+		{Start: "2021-07-21", End: "2020-05-11", Expected: "-P1Y2M10D", Months: -14, Years: -1},
+		{Start: "2023-01-31", End: "2023-02-28", Expected: "P1M", Months: 1, Years: 0},
+		{Start: "2023-01-31", End: "2023-03-01", Expected: "P1M1D", Months: 1, Years: 0},
+		{Start: "2023-01-31", End: "2023-03-30", Expected: "P1M30D", Months: 1, Years: 0},
+		{Start: "2023-01-31", End: "2023-03-31", Expected: "P2M", Months: 2, Years: 0},
+		{Start: "2020-02-29", End: "2021-02-28", Expected: "P1Y", Months: 12, Years: 1},
+		{Start: "2020-02-29", End: "2024-02-28", Expected: "P3Y11M30D", Months: 47, Years: 3},
+		{Start: "2020-02-29", End: "2024-02-29", Expected: "P4Y", Months: 48, Years: 4},
+		{Start: "2024-03-31", End: "2024-02-29", Expected: "-P1M", Months: -1, Years: 0},
+		{Start: "2024-03-31", End: "2024-02-28", Expected: "-P1M1D", Months: -1, Years: 0},
+		{Start: "2024-03-15", End: "2024-02-20", Expected: "-P24D", Months: 0, Years: 0},
+		{Start: "1990-06-15", End: "2024-06-14", Expected: "P33Y11M30D", Months: 407, Years: 33},
+		{Start: "1990-06-15", End: "2024-06-15", Expected: "P34Y", Months: 408, Years: 34},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s -> %s", tc.Start, tc.End)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			start := mustDate(assert, tc.Start)
+			end := mustDate(assert, tc.End)
+
+			p, err := date.Between(start, end)
+			assert.Nil(err)
+			assert.Equal(tc.Expected, p.String())
+			assert.Equal(end, start.AddPeriod(p))
+			assert.Equal(tc.Months, date.MonthsBetween(start, end))
+			assert.Equal(tc.Years, date.YearsBetween(start, end))
+		})
+	}
+}
+
+func TestBetween_Infinite(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(2024, 1, 1)
+	p, err := date.Between(d, date.Infinity)
+	assert.Equal(date.Period{}, p)
+	assert.True(errors.Is(err, date.ErrSubInfinite))
+	assert.Equal("cannot subtract infinite dates; start=2024-01-01, end=infinity", fmt.Sprintf("%v", err))
+
+	p, err = date.Between(date.NegativeInfinity, d)
+	assert.Equal(date.Period{}, p)
+	assert.True(errors.Is(err, date.ErrSubInfinite))
+
+	assert.Equal(math.MaxInt, date.MonthsBetween(d, date.Infinity))
+	assert.Equal(math.MinInt, date.MonthsBetween(d, date.NegativeInfinity))
+	assert.Equal(math.MaxInt, date.MonthsBetween(date.NegativeInfinity, d))
+	assert.Equal(0, date.MonthsBetween(date.Infinity, date.Infinity))
+	assert.Equal(math.MaxInt, date.YearsBetween(d, date.Infinity))
+	assert.Equal(math.MinInt, date.YearsBetween(date.Infinity, d))
+}
+
+func TestBetween_RoundTrip(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	start := date.NewDate(2019, 12, 1)
+	for i := 0; i < 120; i++ {
+		d1 := start.AddDays(i * 7)
+		for j := 0; j < 800; j += 13 {
+			d2 := start.AddDays(j)
+			p, err := date.Between(d1, d2)
+			assert.Nil(err)
+			assert.Equal(d2, d1.AddPeriod(p), "%s -> %s", d1, d2)
+			assert.Equal(0, p.Weeks)
+			assert.True(p.Months > -12 && p.Months < 12)
+			assert.True(p.Days > -31 && p.Days < 31)
+
+			// All components have the same sign
+			if d2.Before(d1) {
+				assert.True(p.Years <= 0 && p.Months <= 0 && p.Days <= 0, "%s -> %s", d1, d2)
+			} else {
+				assert.True(p.Years >= 0 && p.Months >= 0 && p.Days >= 0, "%s -> %s", d1, d2)
+			}
+		}
+	}
+}
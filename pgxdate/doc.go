@@ -0,0 +1,23 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pgxdate provides a native `pgx` (v5) codec for `date.Date` and
+// `date.NullDate`.
+//
+// When using `pgx` directly (rather than via `database/sql`), the `date` type
+// is sent in the binary protocol as the number of days since 2000-01-01. The
+// codec in this package encodes and scans these values directly into a
+// `date.Date` rather than going through `time.Time{}` and `date.FromTime()`.
+// The codec is registered on a connection's type map via `Register()`.
+package pgxdate
@@ -0,0 +1,24 @@
+module github.com/hardfinhq/go-date/pgxdate
+
+go 1.22.1
+
+require (
+	github.com/hardfinhq/go-date v0.0.0
+	github.com/jackc/pgx/v5 v5.7.4
+	github.com/stretchr/testify v1.11.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rogpeppe/go-internal v1.6.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// NOTE: Use the local copy of `go-date` so that changes to both modules can
+//       be developed together. Since `replace` is ignored by consumers, a
+//       root `vX.Y.Z` tag and a `pgxdate/vX.Y.Z` tag must be cut together,
+//       with the `go-date` requirement above bumped to the root tag first
+//       (see the README).
+replace github.com/hardfinhq/go-date => ../
@@ -0,0 +1,257 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgxdate
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+// NOTE: Ensure that `Codec` satisfies `pgtype.Codec`.
+var (
+	_ pgtype.Codec = Codec{}
+)
+
+const (
+	// pgEpochDays is the number of days from the Unix epoch to 2000-01-01, the
+	// epoch used by Postgres for the binary representation of a `DATE`.
+	pgEpochDays          = 10_957
+	infinityDays         = math.MaxInt32
+	negativeInfinityDays = math.MinInt32
+)
+
+// Register registers `Codec` for the `date` and `date[]` types in a pgx type
+// map and makes `date.Date` and `date.NullDate` (and slices of them) default
+// to the `date` type. This is intended to be called for each new connection,
+// e.g. in `pgxpool.Config{}.AfterConnect` via `Register(conn.TypeMap())`.
+func Register(m *pgtype.Map) {
+	dateType := &pgtype.Type{Name: "date", OID: pgtype.DateOID, Codec: Codec{}}
+	m.RegisterType(dateType)
+	m.RegisterType(&pgtype.Type{
+		Name:  "_date",
+		OID:   pgtype.DateArrayOID,
+		Codec: &pgtype.ArrayCodec{ElementType: dateType},
+	})
+
+	m.RegisterDefaultPgType(date.Date{}, "date")
+	m.RegisterDefaultPgType(date.NullDate{}, "date")
+	m.RegisterDefaultPgType(date.StrictDate{}, "date")
+	m.RegisterDefaultPgType(date.NullStrictDate{}, "date")
+	m.RegisterDefaultPgType([]date.Date{}, "_date")
+	m.RegisterDefaultPgType([]date.NullDate{}, "_date")
+}
+
+// Codec is a `pgtype.Codec` for the Postgres `date` type that encodes and
+// scans `date.Date` and `date.NullDate` values directly, in both the binary
+// and text formats. The infinite dates `infinity` and `-infinity` are mapped
+// to `date.Infinity` and `date.NegativeInfinity`. A `date.StrictDate` (or
+// `date.NullStrictDate`) that is not valid fails to encode.
+//
+// All other Go types (e.g. `time.Time` and `pgtype.Date`) are handled by the
+// standard `pgtype.DateCodec`.
+type Codec struct{}
+
+// FormatSupported returns true if the format is supported.
+func (Codec) FormatSupported(format int16) bool {
+	return format == pgtype.TextFormatCode || format == pgtype.BinaryFormatCode
+}
+
+// PreferredFormat returns the preferred format.
+func (Codec) PreferredFormat() int16 {
+	return pgtype.BinaryFormatCode
+}
+
+// PlanEncode returns an `EncodePlan` for encoding a value into the `date` type.
+func (Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case date.Date, date.NullDate, date.StrictDate, date.NullStrictDate:
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return encodePlanBinary{}
+		case pgtype.TextFormatCode:
+			return encodePlanText{}
+		}
+		return nil
+	}
+
+	return pgtype.DateCodec{}.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan returns a `ScanPlan` for scanning a `date` value into a target.
+func (Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *date.Date, *date.NullDate:
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return scanPlanBinary{}
+		case pgtype.TextFormatCode:
+			return scanPlanText{}
+		}
+		return nil
+	}
+
+	return pgtype.DateCodec{}.PlanScan(m, oid, format, target)
+}
+
+// DecodeDatabaseSQLValue returns a `date` value decoded into a value
+// compatible with the `sql.Scanner` interface. This is handled by the
+// standard `pgtype.DateCodec`.
+func (Codec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	return pgtype.DateCodec{}.DecodeDatabaseSQLValue(m, oid, format, src)
+}
+
+// DecodeValue returns a `date` value decoded into a `date.Date` (or `nil`
+// for `NULL`).
+func (Codec) DecodeValue(_ *pgtype.Map, _ uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := decode(format, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// toEncode returns the date to be encoded, or `false` if the value is `NULL`.
+// A `date.StrictDate` (or `date.NullStrictDate`) that is not valid returns an
+// error.
+func toEncode(value any) (date.Date, bool, error) {
+	switch typed := value.(type) {
+	case date.Date:
+		return typed, true, nil
+	case date.NullDate:
+		return typed.Date, typed.Valid, nil
+	case date.StrictDate:
+		return typed.Date, true, typed.Date.Validate()
+	case date.NullStrictDate:
+		if !typed.Valid {
+			return date.Date{}, false, nil
+		}
+		return typed.Date, true, typed.Date.Validate()
+	default:
+		return date.Date{}, false, nil
+	}
+}
+
+type encodePlanBinary struct{}
+
+func (encodePlanBinary) Encode(value any, buf []byte) ([]byte, error) {
+	d, ok, err := toEncode(value)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	var days int64
+	switch d {
+	case date.Infinity:
+		days = infinityDays
+	case date.NegativeInfinity:
+		days = negativeInfinityDays
+	default:
+		days = d.EpochDays() - pgEpochDays
+		if days <= negativeInfinityDays || days >= infinityDays {
+			return nil, fmt.Errorf("date out of range for Postgres; %s", d)
+		}
+	}
+
+	return binary.BigEndian.AppendUint32(buf, uint32(int32(days))), nil
+}
+
+type encodePlanText struct{}
+
+func (encodePlanText) Encode(value any, buf []byte) ([]byte, error) {
+	d, ok, err := toEncode(value)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	return append(buf, d.String()...), nil
+}
+
+// decode decodes a non-`NULL` value in the binary or text format.
+func decode(format int16, src []byte) (date.Date, error) {
+	if format == pgtype.TextFormatCode {
+		return date.FromString(string(src))
+	}
+
+	if len(src) != 4 {
+		return date.Date{}, fmt.Errorf("invalid length for date; length=%d", len(src))
+	}
+
+	days := int32(binary.BigEndian.Uint32(src))
+	switch days {
+	case infinityDays:
+		return date.Infinity, nil
+	case negativeInfinityDays:
+		return date.NegativeInfinity, nil
+	}
+
+	return date.FromEpochDays(int64(days) + pgEpochDays), nil
+}
+
+type scanPlanBinary struct{}
+
+func (scanPlanBinary) Scan(src []byte, target any) error {
+	return scan(pgtype.BinaryFormatCode, src, target)
+}
+
+type scanPlanText struct{}
+
+func (scanPlanText) Scan(src []byte, target any) error {
+	return scan(pgtype.TextFormatCode, src, target)
+}
+
+func scan(format int16, src []byte, target any) error {
+	if src == nil {
+		switch typed := target.(type) {
+		case *date.NullDate:
+			*typed = date.NullDate{}
+			return nil
+		default:
+			return fmt.Errorf("cannot scan NULL into %T", target)
+		}
+	}
+
+	d, err := decode(format, src)
+	if err != nil {
+		return err
+	}
+
+	switch typed := target.(type) {
+	case *date.Date:
+		*typed = d
+	case *date.NullDate:
+		*typed = date.NullDate{Date: d, Valid: true}
+	default:
+		return fmt.Errorf("cannot scan date into %T", target)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgxdate_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+	"github.com/hardfinhq/go-date/pgxdate"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	pgxdate.Register(m)
+	return m
+}
+
+func TestCodec_Binary(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date    date.Date
+		Encoded []byte
+	}
+
+	cases := []testCase{
+		{Date: date.NewDate(2000, time.January, 1), Encoded: []byte{0x00, 0x00, 0x00, 0x00}},
+		{Date: date.NewDate(2000, time.January, 2), Encoded: []byte{0x00, 0x00, 0x00, 0x01}},
+		{Date: date.NewDate(1999, time.December, 31), Encoded: []byte{0xff, 0xff, 0xff, 0xff}},
+		{Date: date.NewDate(2024, time.February, 29), Encoded: []byte{0x00, 0x00, 0x22, 0x79}},
+		{Date: date.NewDate(1, time.January, 1), Encoded: []byte{0xff, 0xf4, 0xdb, 0xf9}},
+		{Date: date.NewDate(9999, time.December, 31), Encoded: []byte{0x00, 0x2c, 0x95, 0xd3}},
+		{Date: date.Infinity, Encoded: []byte{0x7f, 0xff, 0xff, 0xff}},
+		{Date: date.NegativeInfinity, Encoded: []byte{0x80, 0x00, 0x00, 0x00}},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date.String(), func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			m := newMap()
+			encoded, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, tc.Date, nil)
+			assert.Nil(err)
+			assert.Equal(tc.Encoded, encoded)
+
+			encoded, err = m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, date.NullDate{Date: tc.Date, Valid: true}, nil)
+			assert.Nil(err)
+			assert.Equal(tc.Encoded, encoded)
+
+			d := date.Date{}
+			err = m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, tc.Encoded, &d)
+			assert.Nil(err)
+			assert.Equal(tc.Date, d)
+
+			nd := date.NullDate{}
+			err = m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, tc.Encoded, &nd)
+			assert.Nil(err)
+			assert.Equal(date.NullDate{Date: tc.Date, Valid: true}, nd)
+
+			// Compare to the standard codec
+			if !tc.Date.IsInfinite() {
+				t := time.Time{}
+				err = m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, tc.Encoded, &t)
+				assert.Nil(err)
+				assert.Equal(tc.Date.ToTime(), t)
+			}
+		})
+	}
+}
+
+func TestCodec_Text(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	m := newMap()
+	for _, d := range []date.Date{date.NewDate(2024, time.February, 29), date.Infinity, date.NegativeInfinity} {
+		encoded, err := m.Encode(pgtype.DateOID, pgtype.TextFormatCode, d, nil)
+		assert.Nil(err)
+		assert.Equal(d.String(), string(encoded))
+
+		scanned := date.Date{}
+		err = m.Scan(pgtype.DateOID, pgtype.TextFormatCode, encoded, &scanned)
+		assert.Nil(err)
+		assert.Equal(d, scanned)
+	}
+
+	scanned := date.Date{}
+	err := m.Scan(pgtype.DateOID, pgtype.TextFormatCode, []byte("2024-02-30"), &scanned)
+	assert.NotNil(err)
+	assert.Equal(`parsing time "2024-02-30": day out of range`, fmt.Sprintf("%v", err))
+}
+
+func TestCodec_Null(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	m := newMap()
+	encoded, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, date.NullDate{}, nil)
+	assert.Nil(err)
+	assert.Nil(encoded)
+
+	nd := date.NullDate{Date: date.NewDate(2024, time.January, 1), Valid: true}
+	err = m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, nil, &nd)
+	assert.Nil(err)
+	assert.Equal(date.NullDate{}, nd)
+
+	d := date.Date{}
+	err = m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, nil, &d)
+	assert.NotNil(err)
+	assert.Equal("cannot scan NULL into *date.Date", fmt.Sprintf("%v", err))
+
+	var ptr *date.Date
+	err = m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, nil, &ptr)
+	assert.Nil(err)
+	assert.Nil(ptr)
+
+	err = m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, []byte{0x00, 0x00, 0x00, 0x01}, &ptr)
+	assert.Nil(err)
+	assert.Equal(date.NewDate(2000, time.January, 2), *ptr)
+}
+
+func TestCodec_Array(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	m := newMap()
+	dates := []date.Date{date.NewDate(2024, time.January, 1), date.Infinity, date.NewDate(1999, time.December, 31)}
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		encoded, err := m.Encode(pgtype.DateArrayOID, format, dates, nil)
+		assert.Nil(err)
+
+		scanned := []date.Date{}
+		err = m.Scan(pgtype.DateArrayOID, format, encoded, &scanned)
+		assert.Nil(err)
+		assert.Equal(dates, scanned)
+	}
+
+	scanned := []date.NullDate{}
+	err := m.Scan(pgtype.DateArrayOID, pgtype.TextFormatCode, []byte("{2024-01-01,NULL,-infinity}"), &scanned)
+	assert.Nil(err)
+	expected := []date.NullDate{
+		{Date: date.NewDate(2024, time.January, 1), Valid: true},
+		{},
+		{Date: date.NegativeInfinity, Valid: true},
+	}
+	assert.Equal(expected, scanned)
+
+	encoded, err := m.Encode(pgtype.DateArrayOID, pgtype.TextFormatCode, expected, nil)
+	assert.Nil(err)
+	assert.Equal("{2024-01-01,NULL,-infinity}", string(encoded))
+}
+
+func TestCodec_DecodeValue(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	m := newMap()
+	dt, ok := m.TypeForOID(pgtype.DateOID)
+	assert.True(ok)
+
+	v, err := dt.Codec.DecodeValue(m, pgtype.DateOID, pgtype.BinaryFormatCode, []byte{0x00, 0x00, 0x00, 0x01})
+	assert.Nil(err)
+	assert.Equal(date.NewDate(2000, time.January, 2), v)
+
+	v, err = dt.Codec.DecodeValue(m, pgtype.DateOID, pgtype.BinaryFormatCode, nil)
+	assert.Nil(err)
+	assert.Nil(v)
+
+	_, err = dt.Codec.DecodeValue(m, pgtype.DateOID, pgtype.BinaryFormatCode, []byte{0x00})
+	assert.NotNil(err)
+	assert.Equal("invalid length for date; length=1", fmt.Sprintf("%v", err))
+}
+
+func TestRegister_DefaultType(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	m := newMap()
+	dt, ok := m.TypeForValue(date.NewDate(2024, time.January, 1))
+	assert.True(ok)
+	assert.Equal("date", dt.Name)
+
+	dt, ok = m.TypeForValue(date.NullDate{})
+	assert.True(ok)
+	assert.Equal("date", dt.Name)
+
+	dt, ok = m.TypeForValue([]date.Date{})
+	assert.True(ok)
+	assert.Equal("_date", dt.Name)
+}
+
+func TestCodec_OutOfRange(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	m := newMap()
+	d := date.NewDate(6_000_000, time.January, 1)
+	encoded, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, d, nil)
+	assert.Nil(encoded)
+	assert.NotNil(err)
+	assert.Equal("unable to encode date.NewDate(6000000, time.January, 1) into binary format for date (OID 1082): date out of range for Postgres; 6000000-01-01", fmt.Sprintf("%v", err))
+}
+
+func TestCodec_StrictDate(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	m := newMap()
+	d := date.NewDate(2024, time.February, 30)
+	encoded, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, d, nil)
+	assert.Nil(err)
+	assert.Equal([]byte{0x00, 0x00, 0x22, 0x7a}, encoded)
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		encoded, err = m.Encode(pgtype.DateOID, format, date.StrictDate{Date: d}, nil)
+		assert.Nil(encoded)
+		assert.True(errors.Is(err, date.ErrInvalidDate))
+
+		encoded, err = m.Encode(pgtype.DateOID, format, date.NullStrictDate{NullDate: date.NullDate{Date: d, Valid: true}}, nil)
+		assert.Nil(encoded)
+		assert.True(errors.Is(err, date.ErrInvalidDate))
+
+		encoded, err = m.Encode(pgtype.DateOID, format, date.NullStrictDate{NullDate: date.NullDate{Date: d}}, nil)
+		assert.Nil(err)
+		assert.Nil(encoded)
+	}
+
+	valid := date.NewDate(2024, time.February, 29)
+	encoded, err = m.Encode(pgtype.DateOID, pgtype.TextFormatCode, date.StrictDate{Date: valid}, nil)
+	assert.Nil(err)
+	assert.Equal([]byte("2024-02-29"), encoded)
+}
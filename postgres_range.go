@@ -0,0 +1,344 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// NOTE: Ensure that
+// - `PostgresDateRange` satisfies `fmt.Stringer`.
+// - `PostgresDateRange` satisfies `encoding.TextMarshaler`.
+// - `PostgresDateRange` satisfies `json.Marshaler`.
+// - `*PostgresDateRange` satisfies `encoding.TextUnmarshaler`.
+// - `*PostgresDateRange` satisfies `json.Unmarshaler`.
+// - `*PostgresDateRange` satisfies `sql.Scanner`.
+// - `PostgresDateRange` satisfies `driver.Valuer`.
+var (
+	_ fmt.Stringer             = PostgresDateRange{}
+	_ encoding.TextMarshaler   = PostgresDateRange{}
+	_ json.Marshaler           = PostgresDateRange{}
+	_ encoding.TextUnmarshaler = (*PostgresDateRange)(nil)
+	_ json.Unmarshaler         = (*PostgresDateRange)(nil)
+	_ sql.Scanner              = (*PostgresDateRange)(nil)
+	_ driver.Valuer            = PostgresDateRange{}
+)
+
+// PostgresDateRange is a value of the Postgres `daterange` type. Unlike a
+// `DateRange`, either bound may be omitted (i.e. unbounded) and the range may
+// be explicitly `empty`.
+//
+// Bounds are stored in the canonical half-open form used by Postgres for
+// discrete ranges; i.e. `Lower` is included in the range and `Upper` is not. A
+// null `Lower` or `Upper` means the range is unbounded in that direction.
+//
+// The exception is an infinite bound (i.e. `infinity` or `-infinity`), which
+// Postgres does not canonicalize; e.g. `[2024-01-01,infinity]` is kept as-is.
+// For these, `LowerExclusive` and `UpperInclusive` record the bound type so
+// the range round-trips. They are ignored for finite bounds.
+type PostgresDateRange struct {
+	Lower          NullDate
+	Upper          NullDate
+	Empty          bool
+	LowerExclusive bool
+	UpperInclusive bool
+}
+
+// PostgresDateRangeFromDateRange converts a `DateRange` into a
+// `PostgresDateRange` with both bounds present (or `empty`).
+func PostgresDateRangeFromDateRange(dr DateRange) PostgresDateRange {
+	if dr.IsEmpty() {
+		return PostgresDateRange{Empty: true}
+	}
+
+	return PostgresDateRange{
+		Lower: NullDate{Date: dr.Start, Valid: true},
+		Upper: NullDate{Date: dr.End, Valid: true},
+	}
+}
+
+// IsEmpty returns true if the range contains no dates.
+func (pr PostgresDateRange) IsEmpty() bool {
+	if pr.Empty {
+		return true
+	}
+
+	if !pr.Lower.Valid || !pr.Upper.Valid {
+		return false
+	}
+
+	if pr.Lower.Date.Equal(pr.Upper.Date) {
+		return !pr.lowerInclusive() || !pr.upperInclusive()
+	}
+
+	return pr.Upper.Date.Before(pr.Lower.Date)
+}
+
+// Contains returns true if the date is contained in the range.
+func (pr PostgresDateRange) Contains(d Date) bool {
+	if pr.IsEmpty() {
+		return false
+	}
+
+	if pr.Lower.Valid {
+		if d.Before(pr.Lower.Date) || (!pr.lowerInclusive() && d.Equal(pr.Lower.Date)) {
+			return false
+		}
+	}
+
+	if pr.Upper.Valid {
+		if pr.Upper.Date.Before(d) || (!pr.upperInclusive() && d.Equal(pr.Upper.Date)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lowerInclusive returns true if the lower bound is included in the range;
+// only an infinite lower bound can be exclusive.
+func (pr PostgresDateRange) lowerInclusive() bool {
+	return !(pr.LowerExclusive && pr.Lower.Date.IsInfinite())
+}
+
+// upperInclusive returns true if the upper bound is included in the range;
+// only an infinite upper bound can be inclusive.
+func (pr PostgresDateRange) upperInclusive() bool {
+	return pr.UpperInclusive && pr.Upper.Date.IsInfinite()
+}
+
+// DateRange converts the range to a `DateRange`. An empty range is converted
+// to an empty `DateRange{}`. If the range is unbounded in either direction, it
+// cannot be converted and `false` will be returned.
+func (pr PostgresDateRange) DateRange() (DateRange, bool) {
+	if pr.IsEmpty() {
+		return DateRange{}, true
+	}
+
+	if !pr.Lower.Valid || !pr.Upper.Valid {
+		return DateRange{}, false
+	}
+
+	return NewDateRange(pr.Lower.Date, pr.Upper.Date), true
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (pr PostgresDateRange) MarshalText() ([]byte, error) {
+	return []byte(pr.String()), nil
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the range in the Postgres
+// range format, e.g. `[2024-01-01,)`.
+func (pr PostgresDateRange) MarshalJSON() ([]byte, error) {
+	s := pr.String()
+	return json.Marshal(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The range
+// must be in the Postgres range format.
+func (pr *PostgresDateRange) UnmarshalText(data []byte) error {
+	parsed, err := PostgresDateRangeFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*pr = parsed
+	return nil
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`; parses the range in the
+// Postgres range format.
+func (pr *PostgresDateRange) UnmarshalJSON(data []byte) error {
+	s := ""
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := PostgresDateRangeFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*pr = parsed
+	return nil
+}
+
+// Scan implements `sql.Scanner`; it unmarshals values of the type `string` or
+// `[]byte` (i.e. a Postgres `daterange`) onto the current `PostgresDateRange`
+// struct.
+func (pr *PostgresDateRange) Scan(src any) error {
+	var s string
+
+	switch srcTyped := src.(type) {
+	case string:
+		s = srcTyped
+	case []byte:
+		s = string(srcTyped)
+	default:
+		return fmt.Errorf("incompatible type for PostgresDateRange; type=%T", src)
+	}
+
+	parsed, err := PostgresDateRangeFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*pr = parsed
+	return nil
+}
+
+// Value implements `driver.Valuer`; it marshals the value to a string in the
+// Postgres range format to be serialized into the database.
+func (pr PostgresDateRange) Value() (driver.Value, error) {
+	return pr.String(), nil
+}
+
+// String implements `fmt.Stringer`; formats the range in the canonical
+// Postgres range format, e.g. `[2024-01-01,2024-02-01)`, `[2024-01-01,)`,
+// `[2024-01-01,infinity]`, `(,)` or `empty`.
+func (pr PostgresDateRange) String() string {
+	if pr.IsEmpty() {
+		return "empty"
+	}
+
+	lower := "("
+	if pr.Lower.Valid {
+		lower = "[" + pr.Lower.Date.String()
+		if !pr.lowerInclusive() {
+			lower = "(" + pr.Lower.Date.String()
+		}
+	}
+
+	upper := ")"
+	if pr.Upper.Valid {
+		upper = pr.Upper.Date.String() + ")"
+		if pr.upperInclusive() {
+			upper = pr.Upper.Date.String() + "]"
+		}
+	}
+
+	return lower + "," + upper
+}
+
+// PostgresDateRangeFromString parses a string in the Postgres range format
+// into a `PostgresDateRange{}`. Either bound may be inclusive or exclusive
+// (e.g. `(2024-01-01,2024-01-31]`) or omitted (e.g. `[2024-01-01,)`) and the
+// literal `empty` is accepted.
+//
+// As with Postgres, the result is normalized to the canonical half-open form,
+// e.g. `(2024-01-01,2024-01-31]` is parsed as `[2024-01-02,2024-02-01)`, and a
+// range with no dates (e.g. `[2024-01-01,2024-01-01)`) is parsed as `empty`.
+// Infinite bounds are not normalized, e.g. `[2024-01-01,infinity]` is kept
+// as-is.
+func PostgresDateRangeFromString(s string) (PostgresDateRange, error) {
+	trimmed := strings.TrimSpace(s)
+	if strings.EqualFold(trimmed, "empty") {
+		return PostgresDateRange{Empty: true}, nil
+	}
+
+	if len(trimmed) < 3 {
+		return PostgresDateRange{}, fmt.Errorf("invalid date range; %q", s)
+	}
+
+	lowerInclusive := false
+	switch trimmed[0] {
+	case '[':
+		lowerInclusive = true
+	case '(':
+		lowerInclusive = false
+	default:
+		return PostgresDateRange{}, fmt.Errorf("invalid date range; %q", s)
+	}
+
+	upperInclusive := false
+	switch trimmed[len(trimmed)-1] {
+	case ']':
+		upperInclusive = true
+	case ')':
+		upperInclusive = false
+	default:
+		return PostgresDateRange{}, fmt.Errorf("invalid date range; %q", s)
+	}
+
+	lowerStr, upperStr, ok := strings.Cut(trimmed[1:len(trimmed)-1], ",")
+	if !ok {
+		return PostgresDateRange{}, fmt.Errorf("invalid date range; %q", s)
+	}
+
+	lower, err := rangeBoundFromString(lowerStr)
+	if err != nil {
+		return PostgresDateRange{}, err
+	}
+
+	upper, err := rangeBoundFromString(upperStr)
+	if err != nil {
+		return PostgresDateRange{}, err
+	}
+
+	if lower.Valid && upper.Valid && upper.Date.Before(lower.Date) {
+		return PostgresDateRange{}, fmt.Errorf("range lower bound must be less than or equal to range upper bound; %q", s)
+	}
+
+	// NOTE: Canonicalize to `[)` form, as Postgres does for discrete ranges.
+	//       Postgres leaves infinite bounds alone, so the bound type is
+	//       kept for those instead.
+	pr := PostgresDateRange{Lower: lower, Upper: upper}
+	if lower.Valid && !lowerInclusive {
+		if lower.Date.IsInfinite() {
+			pr.LowerExclusive = true
+		} else {
+			pr.Lower.Date = lower.Date.AddDays(1)
+		}
+	}
+	if upper.Valid && upperInclusive {
+		if upper.Date.IsInfinite() {
+			pr.UpperInclusive = true
+		} else {
+			pr.Upper.Date = upper.Date.AddDays(1)
+		}
+	}
+
+	if pr.IsEmpty() {
+		return PostgresDateRange{Empty: true}, nil
+	}
+
+	return pr, nil
+}
+
+// rangeBoundFromString parses a single (possibly double quoted) bound of a
+// Postgres range. An omitted bound is unbounded and is parsed as null.
+func rangeBoundFromString(s string) (NullDate, error) {
+	bound := strings.TrimSpace(s)
+	if len(bound) >= 2 && bound[0] == '"' && bound[len(bound)-1] == '"' {
+		bound = bound[1 : len(bound)-1]
+	}
+
+	if bound == "" {
+		return NullDate{}, nil
+	}
+
+	d, err := FromString(bound)
+	if err != nil {
+		return NullDate{}, err
+	}
+
+	return NullDate{Date: d, Valid: true}, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestPostgresDateRangeFromString(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input    string
+		Expected string
+		Error    string
+	}
+
+	cases := []testCase{
+		{Input: "[2024-01-01,2024-02-01)", Expected: "[2024-01-01,2024-02-01)"},
+		{Input: "[2024-01-01,2024-01-31]", Expected: "[2024-01-01,2024-02-01)"},
+		{Input: "(2023-12-31,2024-02-01)", Expected: "[2024-01-01,2024-02-01)"},
+		{Input: "(2023-12-31,2024-01-31]", Expected: "[2024-01-01,2024-02-01)"},
+		{Input: `["2024-01-01","2024-02-01")`, Expected: "[2024-01-01,2024-02-01)"},
+		{Input: " [ 2024-01-01 , 2024-02-01 ) ", Expected: "[2024-01-01,2024-02-01)"},
+		{Input: "[2024-01-01,)", Expected: "[2024-01-01,)"},
+		{Input: "[2024-01-01,]", Expected: "[2024-01-01,)"},
+		{Input: "(2023-12-31,)", Expected: "[2024-01-01,)"},
+		{Input: "(,2024-02-01)", Expected: "(,2024-02-01)"},
+		{Input: "[,2024-01-31]", Expected: "(,2024-02-01)"},
+		{Input: "(,)", Expected: "(,)"},
+		{Input: "[2024-01-01,infinity]", Expected: "[2024-01-01,infinity]"},
+		{Input: "[2024-01-01,infinity)", Expected: "[2024-01-01,infinity)"},
+		{Input: "(2023-12-31,infinity]", Expected: "[2024-01-01,infinity]"},
+		{Input: "(-infinity,2024-01-31]", Expected: "(-infinity,2024-02-01)"},
+		{Input: "[-infinity,infinity]", Expected: "[-infinity,infinity]"},
+		{Input: "[infinity,infinity]", Expected: "[infinity,infinity]"},
+		{Input: "[infinity,infinity)", Expected: "empty"},
+		{Input: "empty", Expected: "empty"},
+		{Input: "EMPTY", Expected: "empty"},
+		{Input: "[2024-01-01,2024-01-01)", Expected: "empty"},
+		{Input: "(2024-01-01,2024-01-01]", Expected: "empty"},
+		{Input: "(2024-01-01,2024-01-02)", Expected: "empty"},
+		{Input: "[2024-01-01,2024-01-01]", Expected: "[2024-01-01,2024-01-02)"},
+		{Input: "", Error: `invalid date range; ""`},
+		{Input: "2024-01-01,2024-02-01", Error: `invalid date range; "2024-01-01,2024-02-01"`},
+		{Input: "[2024-01-01,2024-02-01", Error: `invalid date range; "[2024-01-01,2024-02-01"`},
+		{Input: "[2024-01-01]", Error: `invalid date range; "[2024-01-01]"`},
+		{Input: "[2024-02-01,2024-01-01)", Error: `range lower bound must be less than or equal to range upper bound; "[2024-02-01,2024-01-01)"`},
+		{Input: "[2024-01-01,2024-02-30)", Error: `parsing time "2024-02-30": day out of range`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			pr, err := date.PostgresDateRangeFromString(tc.Input)
+			if tc.Error != "" {
+				assert.NotNil(err)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				assert.Equal(date.PostgresDateRange{}, pr)
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Expected, pr.String())
+
+			// Round trip
+			again, err := date.PostgresDateRangeFromString(pr.String())
+			assert.Nil(err)
+			assert.Equal(pr, again)
+		})
+	}
+}
+
+func TestPostgresDateRange_Contains(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(2024, time.January, 15)
+
+	pr, err := date.PostgresDateRangeFromString("[2024-01-15,)")
+	assert.Nil(err)
+	assert.True(pr.Contains(d))
+	assert.False(pr.Contains(d.AddDays(-1)))
+	assert.True(pr.Contains(date.NewDate(9999, time.December, 31)))
+
+	pr, err = date.PostgresDateRangeFromString("(,2024-01-15)")
+	assert.Nil(err)
+	assert.False(pr.Contains(d))
+	assert.True(pr.Contains(d.AddDays(-1)))
+
+	pr, err = date.PostgresDateRangeFromString("(,)")
+	assert.Nil(err)
+	assert.True(pr.Contains(d))
+
+	pr, err = date.PostgresDateRangeFromString("[2024-01-15,infinity]")
+	assert.Nil(err)
+	assert.True(pr.Contains(date.Infinity))
+	assert.True(pr.Contains(d))
+
+	pr, err = date.PostgresDateRangeFromString("(-infinity,infinity)")
+	assert.Nil(err)
+	assert.False(pr.Contains(date.Infinity))
+	assert.False(pr.Contains(date.NegativeInfinity))
+	assert.True(pr.Contains(d))
+
+	pr, err = date.PostgresDateRangeFromString("empty")
+	assert.Nil(err)
+	assert.False(pr.Contains(d))
+}
+
+func TestPostgresDateRange_DateRange(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	dr := date.NewDateRange(date.NewDate(2024, time.January, 1), date.NewDate(2024, time.February, 1))
+	pr := date.PostgresDateRangeFromDateRange(dr)
+	assert.Equal("[2024-01-01,2024-02-01)", pr.String())
+	converted, ok := pr.DateRange()
+	assert.True(ok)
+	assert.Equal(dr, converted)
+
+	pr = date.PostgresDateRangeFromDateRange(date.DateRange{})
+	assert.Equal(date.PostgresDateRange{Empty: true}, pr)
+	converted, ok = pr.DateRange()
+	assert.True(ok)
+	assert.Equal(date.DateRange{}, converted)
+
+	pr = date.PostgresDateRange{Lower: date.NullDate{Date: dr.Start, Valid: true}}
+	converted, ok = pr.DateRange()
+	assert.False(ok)
+	assert.Equal(date.DateRange{}, converted)
+
+	// Bounds out of order
+	pr = date.PostgresDateRange{
+		Lower: date.NullDate{Date: dr.End, Valid: true},
+		Upper: date.NullDate{Date: dr.Start, Valid: true},
+	}
+	assert.True(pr.IsEmpty())
+	assert.Equal("empty", pr.String())
+}
+
+func TestPostgresDateRange_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	type coverage struct {
+		Period date.PostgresDateRange `json:"period"`
+	}
+
+	pr, err := date.PostgresDateRangeFromString("[2024-01-01,)")
+	assert.Nil(err)
+	asBytes, err := json.Marshal(coverage{Period: pr})
+	assert.Nil(err)
+	assert.Equal(`{"period":"[2024-01-01,)"}`, string(asBytes))
+
+	parsed := coverage{}
+	err = json.Unmarshal(asBytes, &parsed)
+	assert.Nil(err)
+	assert.Equal(pr, parsed.Period)
+
+	err = json.Unmarshal([]byte(`{"period":"[2024-02-01,2024-01-01)"}`), &parsed)
+	assert.NotNil(err)
+	assert.Equal(`range lower bound must be less than or equal to range upper bound; "[2024-02-01,2024-01-01)"`, fmt.Sprintf("%v", err))
+
+	asBytes, err = pr.MarshalText()
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,)", string(asBytes))
+
+	pr = date.PostgresDateRange{}
+	err = pr.UnmarshalText([]byte("empty"))
+	assert.Nil(err)
+	assert.Equal(date.PostgresDateRange{Empty: true}, pr)
+
+	err = pr.UnmarshalText([]byte("[x,)"))
+	assert.NotNil(err)
+	assert.Equal(`parsing time "x" as "2006-01-02": cannot parse "x" as "2006"`, fmt.Sprintf("%v", err))
+}
+
+func TestPostgresDateRange_Scan(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	// Wrong type
+	pr := date.PostgresDateRange{}
+	err := pr.Scan(1)
+	assert.NotNil(err)
+	assert.Equal("incompatible type for PostgresDateRange; type=int", fmt.Sprintf("%v", err))
+	assert.Equal(date.PostgresDateRange{}, pr)
+
+	// Happy path: string
+	err = pr.Scan("(,2024-02-01)")
+	assert.Nil(err)
+	assert.Equal("(,2024-02-01)", pr.String())
+
+	// Happy path: bytes
+	err = pr.Scan([]byte("empty"))
+	assert.Nil(err)
+	assert.Equal(date.PostgresDateRange{Empty: true}, pr)
+}
+
+func TestPostgresDateRange_Value(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	pr := date.PostgresDateRange{Lower: date.NullDate{Date: date.NewDate(2024, time.January, 1), Valid: true}}
+	v, err := pr.Value()
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,)", v)
+
+	v, err = date.PostgresDateRange{Empty: true}.Value()
+	assert.Nil(err)
+	assert.Equal("empty", v)
+}
@@ -0,0 +1,319 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// NOTE: Ensure that
+// - `DateRange` satisfies `fmt.Stringer`.
+// - `DateRange` satisfies `encoding.TextMarshaler`.
+// - `DateRange` satisfies `json.Marshaler`.
+// - `*DateRange` satisfies `encoding.TextUnmarshaler`.
+// - `*DateRange` satisfies `json.Unmarshaler`.
+// - `*DateRange` satisfies `sql.Scanner`.
+// - `DateRange` satisfies `driver.Valuer`.
+var (
+	_ fmt.Stringer             = DateRange{}
+	_ encoding.TextMarshaler   = DateRange{}
+	_ json.Marshaler           = DateRange{}
+	_ encoding.TextUnmarshaler = (*DateRange)(nil)
+	_ json.Unmarshaler         = (*DateRange)(nil)
+	_ sql.Scanner              = (*DateRange)(nil)
+	_ driver.Valuer            = DateRange{}
+)
+
+// DateRange is a half-open range of dates `[Start, End)`, i.e. `Start` is
+// included in the range and `End` is not. This matches the canonical form used
+// by Postgres for the `daterange` type.
+//
+// A range with `End` on or before `Start` is empty.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// NewDateRange returns a new half-open `DateRange` `[start, end)`; the end
+// date is **excluded** from the range.
+func NewDateRange(start, end Date) DateRange {
+	return DateRange{Start: start, End: end}
+}
+
+// NewDateRangeInclusive returns a new `DateRange` containing all dates from
+// `first` through `last`; i.e. the closed range `[first, last]`. This is
+// stored in half-open form as `[first, last + 1 day)`.
+func NewDateRangeInclusive(first, last Date) DateRange {
+	return DateRange{Start: first, End: last.AddDays(1)}
+}
+
+// IsEmpty returns true if the range contains no dates.
+func (dr DateRange) IsEmpty() bool {
+	return !dr.Start.Before(dr.End)
+}
+
+// Last returns the last date contained in the range, i.e. the inclusive end
+// of the range. For an empty range, this will be before `Start`.
+func (dr DateRange) Last() Date {
+	return dr.End.AddDays(-1)
+}
+
+// Days returns the number of dates contained in the range. For an empty
+// range, this is 0. For a (non-empty) range with an infinite bound, this
+// saturates to `math.MaxInt64`.
+func (dr DateRange) Days() int64 {
+	if dr.IsEmpty() {
+		return 0
+	}
+	if dr.Start.IsInfinite() || dr.End.IsInfinite() {
+		return math.MaxInt64
+	}
+
+	return dr.End.Sub(dr.Start)
+}
+
+// Contains returns true if the date is contained in the range.
+func (dr DateRange) Contains(d Date) bool {
+	return !d.Before(dr.Start) && d.Before(dr.End)
+}
+
+// ContainsRange returns true if every date in the other range is contained in
+// this range. An empty range is contained in every range.
+func (dr DateRange) ContainsRange(other DateRange) bool {
+	if other.IsEmpty() {
+		return true
+	}
+
+	return !other.Start.Before(dr.Start) && !dr.End.Before(other.End)
+}
+
+// Overlaps returns true if there is at least one date contained in both
+// ranges.
+func (dr DateRange) Overlaps(other DateRange) bool {
+	return !dr.Intersect(other).IsEmpty()
+}
+
+// Adjacent returns true if the ranges are non-empty and one range ends
+// exactly where the other starts, e.g. `[2024-01-01, 2024-02-01)` and
+// `[2024-02-01, 2024-03-01)`.
+func (dr DateRange) Adjacent(other DateRange) bool {
+	if dr.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+
+	return dr.End.Equal(other.Start) || other.End.Equal(dr.Start)
+}
+
+// Intersect returns the range of dates contained in both ranges. If the ranges
+// do not overlap, the result will be empty.
+func (dr DateRange) Intersect(other DateRange) DateRange {
+	start := dr.Start
+	if start.Before(other.Start) {
+		start = other.Start
+	}
+
+	end := dr.End
+	if other.End.Before(end) {
+		end = other.End
+	}
+
+	return DateRange{Start: start, End: end}
+}
+
+// Union returns the smallest range containing both ranges. This is only
+// possible if the ranges overlap or are adjacent (i.e. contiguous); otherwise
+// `false` will be returned. If either range is empty, the other is returned.
+func (dr DateRange) Union(other DateRange) (DateRange, bool) {
+	if dr.IsEmpty() {
+		return other, true
+	}
+
+	if other.IsEmpty() {
+		return dr, true
+	}
+
+	if dr.End.Before(other.Start) || other.End.Before(dr.Start) {
+		return DateRange{}, false
+	}
+
+	start := dr.Start
+	if other.Start.Before(start) {
+		start = other.Start
+	}
+
+	end := dr.End
+	if end.Before(other.End) {
+		end = other.End
+	}
+
+	return DateRange{Start: start, End: end}, true
+}
+
+// Equal returns true if the range is equal to the other range. All empty ranges
+// are considered equal.
+func (dr DateRange) Equal(other DateRange) bool {
+	if dr.IsEmpty() || other.IsEmpty() {
+		return dr.IsEmpty() && other.IsEmpty()
+	}
+
+	return dr.Start.Equal(other.Start) && dr.End.Equal(other.End)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (dr DateRange) MarshalText() ([]byte, error) {
+	return []byte(dr.String()), nil
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the range as
+// `[YYYY-MM-DD,YYYY-MM-DD)` (or `empty`).
+func (dr DateRange) MarshalJSON() ([]byte, error) {
+	s := dr.String()
+	return json.Marshal(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The range
+// must be in the format `[YYYY-MM-DD,YYYY-MM-DD)`, `[YYYY-MM-DD,YYYY-MM-DD]`
+// or `empty`.
+func (dr *DateRange) UnmarshalText(data []byte) error {
+	parsed, err := DateRangeFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*dr = parsed
+	return nil
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`; parses the range as
+// `[YYYY-MM-DD,YYYY-MM-DD)`, `[YYYY-MM-DD,YYYY-MM-DD]` or `empty`.
+func (dr *DateRange) UnmarshalJSON(data []byte) error {
+	s := ""
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := DateRangeFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*dr = parsed
+	return nil
+}
+
+// Scan implements `sql.Scanner`; it unmarshals values of the type `string` or
+// `[]byte` (e.g. a Postgres `daterange`) onto the current `DateRange` struct.
+// The value is parsed via `PostgresDateRangeFromString()`, so any bounds are
+// accepted and `empty` is scanned as an empty range. Unbounded ranges cannot
+// be represented by a `DateRange`; use a `PostgresDateRange` instead.
+func (dr *DateRange) Scan(src any) error {
+	var s string
+
+	switch srcTyped := src.(type) {
+	case string:
+		s = srcTyped
+	case []byte:
+		s = string(srcTyped)
+	default:
+		return fmt.Errorf("incompatible type for DateRange; type=%T", src)
+	}
+
+	parsed, err := PostgresDateRangeFromString(s)
+	if err != nil {
+		return err
+	}
+
+	converted, ok := parsed.DateRange()
+	if !ok {
+		return fmt.Errorf("unbounded date range cannot be scanned into DateRange; %q", s)
+	}
+
+	*dr = converted
+	return nil
+}
+
+// Value implements `driver.Valuer`; it marshals the value to a string of the
+// form `[YYYY-MM-DD,YYYY-MM-DD)` to be serialized into the database. An empty
+// range is marshaled to `empty`, matching Postgres.
+func (dr DateRange) Value() (driver.Value, error) {
+	return dr.String(), nil
+}
+
+// String implements `fmt.Stringer`; formats the range as
+// `[YYYY-MM-DD,YYYY-MM-DD)`. An empty range is formatted as `empty`, matching
+// Postgres.
+func (dr DateRange) String() string {
+	if dr.IsEmpty() {
+		return "empty"
+	}
+
+	return fmt.Sprintf("[%s,%s)", dr.Start, dr.End)
+}
+
+// DateRangeFromString parses a string of the form `[YYYY-MM-DD,YYYY-MM-DD)`
+// (half-open) or `[YYYY-MM-DD,YYYY-MM-DD]` (closed) into a `DateRange{}`. The
+// literal `empty` (as produced by `String()`) is parsed as an empty range. As in
+// Postgres, an upper bound before the lower bound is an error.
+func DateRangeFromString(s string) (DateRange, error) {
+	if strings.EqualFold(s, "empty") {
+		return DateRange{}, nil
+	}
+
+	if len(s) < 2 || s[0] != '[' {
+		return DateRange{}, fmt.Errorf("invalid date range; %q", s)
+	}
+
+	inclusive := false
+	switch s[len(s)-1] {
+	case ')':
+		inclusive = false
+	case ']':
+		inclusive = true
+	default:
+		return DateRange{}, fmt.Errorf("invalid date range; %q", s)
+	}
+
+	startStr, endStr, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return DateRange{}, fmt.Errorf("invalid date range; %q", s)
+	}
+
+	start, err := FromString(startStr)
+	if err != nil {
+		return DateRange{}, err
+	}
+
+	end, err := FromString(endStr)
+	if err != nil {
+		return DateRange{}, err
+	}
+
+	if end.Before(start) {
+		return DateRange{}, fmt.Errorf("range lower bound must be less than or equal to range upper bound; %q", s)
+	}
+
+	if inclusive {
+		return NewDateRangeInclusive(start, end), nil
+	}
+
+	return NewDateRange(start, end), nil
+}
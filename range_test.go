@@ -0,0 +1,396 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func mustRange(assert *testifyrequire.Assertions, s string) date.DateRange {
+	dr, err := date.DateRangeFromString(s)
+	assert.Nil(err)
+	return dr
+}
+
+func TestNewDateRange(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	start := date.NewDate(2024, time.January, 1)
+	end := date.NewDate(2024, time.February, 1)
+
+	dr := date.NewDateRange(start, end)
+	assert.Equal(date.DateRange{Start: start, End: end}, dr)
+	assert.Equal(int64(31), dr.Days())
+	assert.Equal(date.NewDate(2024, time.January, 31), dr.Last())
+
+	dr = date.NewDateRangeInclusive(start, end)
+	assert.Equal(date.DateRange{Start: start, End: date.NewDate(2024, time.February, 2)}, dr)
+	assert.Equal(int64(32), dr.Days())
+	assert.Equal(end, dr.Last())
+}
+
+func TestDateRange_IsEmpty(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(2024, time.January, 1)
+	assert.True(date.NewDateRange(d, d).IsEmpty())
+	assert.True(date.NewDateRange(d, d.AddDays(-1)).IsEmpty())
+	assert.False(date.NewDateRange(d, d.AddDays(1)).IsEmpty())
+	assert.False(date.NewDateRangeInclusive(d, d).IsEmpty())
+
+	assert.Equal(int64(0), date.NewDateRange(d, d.AddDays(-10)).Days())
+}
+
+func TestDateRange_Days(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d := date.NewDate(2024, time.January, 1)
+	assert.Equal(int64(366), date.NewDateRange(d, d.AddYears(1)).Days())
+	assert.Equal(int64(math.MaxInt64), date.NewDateRange(d, date.Infinity).Days())
+	assert.Equal(int64(math.MaxInt64), date.NewDateRange(date.NegativeInfinity, d).Days())
+	assert.Equal(int64(math.MaxInt64), date.NewDateRange(date.NegativeInfinity, date.Infinity).Days())
+	assert.Equal(int64(0), date.NewDateRange(date.Infinity, date.Infinity).Days())
+	assert.Equal(int64(0), date.NewDateRange(date.Infinity, d).Days())
+}
+
+func TestDateRange_Contains(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Range    string
+		Date     string
+		Expected bool
+	}
+
+	cases := []testCase{
+		{Range: "[2024-01-01,2024-02-01)", Date: "2023-12-31", Expected: false},
+		{Range: "[2024-01-01,2024-02-01)", Date: "2024-01-01", Expected: true},
+		{Range: "[2024-01-01,2024-02-01)", Date: "2024-01-31", Expected: true},
+		{Range: "[2024-01-01,2024-02-01)", Date: "2024-02-01", Expected: false},
+		{Range: "[2024-01-01,2024-02-01]", Date: "2024-02-01", Expected: true},
+		{Range: "[2024-01-01,2024-02-01]", Date: "2024-02-02", Expected: false},
+		{Range: "[2024-01-01,2024-01-01)", Date: "2024-01-01", Expected: false},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s contains %s", tc.Range, tc.Date)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			dr := mustRange(assert, tc.Range)
+			d, err := date.FromString(tc.Date)
+			assert.Nil(err)
+
+			assert.Equal(tc.Expected, dr.Contains(d))
+		})
+	}
+}
+
+func TestDateRange_ContainsRange(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	dr := mustRange(assert, "[2024-01-01,2024-02-01)")
+	assert.True(dr.ContainsRange(dr))
+	assert.True(dr.ContainsRange(mustRange(assert, "[2024-01-10,2024-01-20)")))
+	assert.True(dr.ContainsRange(mustRange(assert, "[2024-01-10,2024-01-31]")))
+	assert.False(dr.ContainsRange(mustRange(assert, "[2024-01-10,2024-02-01]")))
+	assert.False(dr.ContainsRange(mustRange(assert, "[2023-12-31,2024-01-10)")))
+	assert.True(dr.ContainsRange(mustRange(assert, "[2025-01-01,2025-01-01)")))
+}
+
+func TestDateRange_Intersect(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Range    string
+		Other    string
+		Expected string
+		Overlaps bool
+	}
+
+	cases := []testCase{
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-01-15,2024-03-01)", Expected: "[2024-01-15,2024-02-01)", Overlaps: true},
+		{Range: "[2024-01-15,2024-03-01)", Other: "[2024-01-01,2024-02-01)", Expected: "[2024-01-15,2024-02-01)", Overlaps: true},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-01-10,2024-01-20)", Expected: "[2024-01-10,2024-01-20)", Overlaps: true},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-01-31,2024-02-05)", Expected: "[2024-01-31,2024-02-01)", Overlaps: true},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-02-01,2024-03-01)", Expected: "empty", Overlaps: false},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-03-01,2024-04-01)", Expected: "empty", Overlaps: false},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s * %s", tc.Range, tc.Other)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			dr := mustRange(assert, tc.Range)
+			other := mustRange(assert, tc.Other)
+
+			intersection := dr.Intersect(other)
+			assert.Equal(tc.Expected, intersection.String())
+			assert.Equal(tc.Overlaps, dr.Overlaps(other))
+			assert.Equal(tc.Overlaps, other.Overlaps(dr))
+			assert.Equal(!tc.Overlaps, intersection.IsEmpty())
+		})
+	}
+}
+
+func TestDateRange_Union(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Range    string
+		Other    string
+		Expected string
+		Adjacent bool
+	}
+
+	cases := []testCase{
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-01-15,2024-03-01)", Expected: "[2024-01-01,2024-03-01)"},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-01-10,2024-01-20)", Expected: "[2024-01-01,2024-02-01)"},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-02-01,2024-03-01)", Expected: "[2024-01-01,2024-03-01)", Adjacent: true},
+		{Range: "[2024-02-01,2024-03-01)", Other: "[2024-01-01,2024-01-31]", Expected: "[2024-01-01,2024-03-01)", Adjacent: true},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2024-02-02,2024-03-01)", Expected: ""},
+		{Range: "[2024-01-01,2024-02-01)", Other: "[2025-01-01,2025-01-01)", Expected: "[2024-01-01,2024-02-01)"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s + %s", tc.Range, tc.Other)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			dr := mustRange(assert, tc.Range)
+			other := mustRange(assert, tc.Other)
+
+			assert.Equal(tc.Adjacent, dr.Adjacent(other))
+			assert.Equal(tc.Adjacent, other.Adjacent(dr))
+
+			union, ok := dr.Union(other)
+			if tc.Expected == "" {
+				assert.False(ok)
+				assert.Equal(date.DateRange{}, union)
+				return
+			}
+
+			assert.True(ok)
+			assert.Equal(tc.Expected, union.String())
+		})
+	}
+}
+
+func TestDateRange_Equal(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	dr := mustRange(assert, "[2024-01-01,2024-02-01)")
+	assert.True(dr.Equal(mustRange(assert, "[2024-01-01,2024-01-31]")))
+	assert.False(dr.Equal(mustRange(assert, "[2024-01-01,2024-02-01]")))
+	reversed := date.NewDateRange(date.NewDate(2025, time.March, 1), date.NewDate(2025, time.February, 1))
+	assert.True(mustRange(assert, "[2024-01-01,2024-01-01)").Equal(reversed))
+	assert.False(dr.Equal(mustRange(assert, "[2024-01-01,2024-01-01)")))
+}
+
+func TestDateRange_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	dr := date.NewDateRangeInclusive(date.NewDate(2024, time.January, 1), date.NewDate(2024, time.January, 31))
+	asBytes, err := json.Marshal(dr)
+	assert.Nil(err)
+	assert.Equal(`"[2024-01-01,2024-02-01)"`, string(asBytes))
+
+	asBytes, err = dr.MarshalText()
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,2024-02-01)", string(asBytes))
+
+	// Empty
+	dr = date.NewDateRange(date.NewDate(2024, time.February, 1), date.NewDate(2024, time.January, 1))
+	asBytes, err = json.Marshal(dr)
+	assert.Nil(err)
+	assert.Equal(`"empty"`, string(asBytes))
+
+	asBytes, err = dr.MarshalText()
+	assert.Nil(err)
+	assert.Equal("empty", string(asBytes))
+
+	// Round trip
+	parsed := date.NewDateRange(date.NewDate(2024, time.January, 1), date.NewDate(2024, time.February, 1))
+	err = json.Unmarshal([]byte(`"empty"`), &parsed)
+	assert.Nil(err)
+	assert.True(parsed.IsEmpty())
+	assert.True(dr.Equal(parsed))
+}
+
+func TestDateRange_UnmarshalJSON(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input []byte
+		Range date.DateRange
+		Error string
+	}
+
+	jan1 := date.NewDate(2024, time.January, 1)
+	feb1 := date.NewDate(2024, time.February, 1)
+	cases := []testCase{
+		{Input: []byte(`x`), Error: "invalid character 'x' looking for beginning of value"},
+		{Input: []byte(`10`), Error: "json: cannot unmarshal number into Go value of type string"},
+		{Input: []byte(`"2024-01-01"`), Error: `invalid date range; "2024-01-01"`},
+		{Input: []byte(`"[2024-01-01)"`), Error: `invalid date range; "[2024-01-01)"`},
+		{Input: []byte(`"[2024-01-01,2024-02-01"`), Error: `invalid date range; "[2024-01-01,2024-02-01"`},
+		{Input: []byte(`"[2024-01-01,x)"`), Error: `parsing time "x" as "2006-01-02": cannot parse "x" as "2006"`},
+		{Input: []byte(`"[x,2024-01-01)"`), Error: `parsing time "x" as "2006-01-02": cannot parse "x" as "2006"`},
+		{Input: []byte(`"[2024-01-01,2024-02-01)"`), Range: date.DateRange{Start: jan1, End: feb1}},
+		{Input: []byte(`"[2024-01-01,2024-01-31]"`), Range: date.DateRange{Start: jan1, End: feb1}},
+		{Input: []byte(`"[2024-01-05,2024-01-01)"`), Error: `range lower bound must be less than or equal to range upper bound; "[2024-01-05,2024-01-01)"`},
+		{Input: []byte(`"[2024-01-02,2024-01-01]"`), Error: `range lower bound must be less than or equal to range upper bound; "[2024-01-02,2024-01-01]"`},
+		{Input: []byte(`"[2024-01-01,2024-01-01)"`), Range: date.DateRange{Start: jan1, End: jan1}},
+		{Input: []byte(`"empty"`), Range: date.DateRange{}},
+		{Input: []byte(`"EMPTY"`), Range: date.DateRange{}},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(string(tc.Input), func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			dr := date.DateRange{}
+			err := json.Unmarshal(tc.Input, &dr)
+			if err != nil {
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				assert.Equal(date.DateRange{}, dr)
+			} else {
+				assert.Equal("", tc.Error)
+				assert.Equal(tc.Range, dr)
+			}
+		})
+	}
+}
+
+func TestDateRange_UnmarshalText(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	dr := date.DateRange{}
+	err := dr.UnmarshalText([]byte("[2024-01-01,2024-02-01)"))
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,2024-02-01)", dr.String())
+
+	dr = date.DateRange{}
+	err = dr.UnmarshalText([]byte("(2024-01-01,2024-02-01)"))
+	assert.NotNil(err)
+	assert.Equal(`invalid date range; "(2024-01-01,2024-02-01)"`, fmt.Sprintf("%v", err))
+	assert.Equal(date.DateRange{}, dr)
+
+	// Reversed bounds are rejected, as in `Scan()`
+	dr = date.DateRange{}
+	err = dr.UnmarshalText([]byte("[2024-01-05,2024-01-01)"))
+	assert.NotNil(err)
+	assert.Equal(date.DateRange{}, dr)
+	err = dr.Scan("[2024-01-05,2024-01-01)")
+	assert.NotNil(err)
+	assert.Equal(date.DateRange{}, dr)
+
+	dr = date.NewDateRange(date.NewDate(2024, time.January, 1), date.NewDate(2024, time.February, 1))
+	err = dr.UnmarshalText([]byte("empty"))
+	assert.Nil(err)
+	assert.Equal(date.DateRange{}, dr)
+	assert.Equal("empty", dr.String())
+}
+
+func TestDateRange_Scan(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	// Wrong type
+	dr := date.DateRange{}
+	err := dr.Scan(1)
+	assert.NotNil(err)
+	assert.Equal("incompatible type for DateRange; type=int", fmt.Sprintf("%v", err))
+	assert.Equal(date.DateRange{}, dr)
+
+	// Happy path: string
+	dr = date.DateRange{}
+	err = dr.Scan("[2024-01-01,2024-02-01)")
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,2024-02-01)", dr.String())
+
+	// Happy path: bytes
+	dr = date.DateRange{}
+	err = dr.Scan([]byte("[2024-01-01,2024-02-01)"))
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,2024-02-01)", dr.String())
+
+	// Happy path: non-canonical bounds
+	dr = date.DateRange{}
+	err = dr.Scan("(2023-12-31,2024-01-31]")
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,2024-02-01)", dr.String())
+
+	// Happy path: empty
+	dr = date.NewDateRange(date.NewDate(2024, time.January, 1), date.NewDate(2024, time.February, 1))
+	err = dr.Scan("empty")
+	assert.Nil(err)
+	assert.Equal(date.DateRange{}, dr)
+
+	// Unbounded
+	dr = date.DateRange{}
+	err = dr.Scan("[2024-01-01,)")
+	assert.NotNil(err)
+	assert.Equal(`unbounded date range cannot be scanned into DateRange; "[2024-01-01,)"`, fmt.Sprintf("%v", err))
+	assert.Equal(date.DateRange{}, dr)
+}
+
+func TestDateRange_Value(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	dr := date.NewDateRange(date.NewDate(2024, time.January, 1), date.NewDate(2024, time.February, 1))
+	v, err := dr.Value()
+	assert.Nil(err)
+	assert.Equal("[2024-01-01,2024-02-01)", v)
+
+	// Empty
+	dr = date.NewDateRange(date.NewDate(2024, time.February, 1), date.NewDate(2024, time.January, 1))
+	v, err = dr.Value()
+	assert.Nil(err)
+	assert.Equal("empty", v)
+}
@@ -0,0 +1,706 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NOTE: Ensure that
+// - `RecurrenceRule` satisfies `fmt.Stringer`.
+// - `RecurrenceRule` satisfies `encoding.TextMarshaler`.
+// - `RecurrenceRule` satisfies `json.Marshaler`.
+// - `*RecurrenceRule` satisfies `encoding.TextUnmarshaler`.
+// - `*RecurrenceRule` satisfies `json.Unmarshaler`.
+// - `RecurrenceWeekday` satisfies `fmt.Stringer`.
+var (
+	_ fmt.Stringer             = RecurrenceRule{}
+	_ encoding.TextMarshaler   = RecurrenceRule{}
+	_ json.Marshaler           = RecurrenceRule{}
+	_ encoding.TextUnmarshaler = (*RecurrenceRule)(nil)
+	_ json.Unmarshaler         = (*RecurrenceRule)(nil)
+	_ fmt.Stringer             = RecurrenceWeekday{}
+)
+
+// maxRecurrenceYear is the last year in which occurrences are generated;
+// this ensures that rules which can never (or never again) produce an
+// occurrence, e.g. FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30, terminate.
+const maxRecurrenceYear = 9999
+
+// RecurrenceFrequency is the FREQ of a recurrence rule. Only date-level
+// frequencies are supported.
+type RecurrenceFrequency int
+
+const (
+	// RecurDaily is FREQ=DAILY.
+	RecurDaily RecurrenceFrequency = iota + 1
+	// RecurWeekly is FREQ=WEEKLY.
+	RecurWeekly
+	// RecurMonthly is FREQ=MONTHLY.
+	RecurMonthly
+	// RecurYearly is FREQ=YEARLY.
+	RecurYearly
+)
+
+// String implements `fmt.Stringer`; the frequency is formatted as it appears
+// in a recurrence rule, e.g. MONTHLY.
+func (rf RecurrenceFrequency) String() string {
+	switch rf {
+	case RecurDaily:
+		return "DAILY"
+	case RecurWeekly:
+		return "WEEKLY"
+	case RecurMonthly:
+		return "MONTHLY"
+	case RecurYearly:
+		return "YEARLY"
+	default:
+		return fmt.Sprintf("RecurrenceFrequency(%d)", int(rf))
+	}
+}
+
+// RecurrenceWeekday is a single BYDAY value in a recurrence rule, e.g. MO,
+// +2TU or -1FR. An ordinal of 0 means every such weekday in the period.
+type RecurrenceWeekday struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// String implements `fmt.Stringer`; formats the weekday as it appears in a
+// recurrence rule, e.g. -1FR.
+func (rw RecurrenceWeekday) String() string {
+	abbreviation := weekdayAbbreviations[rw.Weekday]
+	if rw.Ordinal == 0 {
+		return abbreviation
+	}
+	return strconv.Itoa(rw.Ordinal) + abbreviation
+}
+
+var weekdayAbbreviations = [7]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// RecurrenceRule is an RFC 5545 recurrence rule (RRULE) restricted to
+// date-level frequencies, e.g. FREQ=MONTHLY;BYDAY=-1FR for the last Friday of
+// every month.
+//
+// An `Interval` of 0 is treated as 1 and a `Count` of 0 means the rule is
+// not limited by a number of occurrences. RFC 5545 defaults `WeekStart` (WKST)
+// to Monday, which `ParseRecurrenceRule()` applies when WKST is absent; note
+// that the zero value of `time.Weekday` is Sunday.
+type RecurrenceRule struct {
+	Frequency  RecurrenceFrequency
+	Interval   int
+	Count      int
+	Until      NullDate
+	ByMonth    []time.Month
+	ByWeekNo   []int
+	ByYearDay  []int
+	ByMonthDay []int
+	ByDay      []RecurrenceWeekday
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// ParseRecurrenceRule parses an RFC 5545 recurrence rule, e.g.
+// FREQ=MONTHLY;INTERVAL=2;BYDAY=1MO,-1FR;COUNT=10. A leading RRULE: is
+// allowed. UNTIL must be a date (e.g. 20241231); for compatibility with rules
+// written for timed events, a date-time (e.g. 20241231T000000Z) is also
+// accepted and only the date is used.
+//
+// Time-level frequencies (HOURLY, MINUTELY, SECONDLY) and rule parts
+// (BYHOUR, BYMINUTE, BYSECOND) are not supported.
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	rest := strings.TrimPrefix(s, "RRULE:")
+	if rest == "" {
+		return RecurrenceRule{}, fmt.Errorf("invalid recurrence rule; %q", s)
+	}
+
+	r := RecurrenceRule{WeekStart: time.Monday}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rest, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return RecurrenceRule{}, fmt.Errorf("invalid recurrence rule; %q", s)
+		}
+		name = strings.ToUpper(name)
+		if seen[name] {
+			return RecurrenceRule{}, fmt.Errorf("duplicate recurrence rule part; %q", part)
+		}
+		seen[name] = true
+
+		err := r.parsePart(name, strings.ToUpper(value))
+		if err != nil {
+			return RecurrenceRule{}, err
+		}
+	}
+
+	if !seen["FREQ"] {
+		return RecurrenceRule{}, fmt.Errorf("recurrence rule requires FREQ; %q", s)
+	}
+
+	err := r.Validate()
+	if err != nil {
+		return RecurrenceRule{}, err
+	}
+
+	return r, nil
+}
+
+func (r *RecurrenceRule) parsePart(name, value string) error {
+	invalid := fmt.Errorf("invalid recurrence rule part; %q", name+"="+value)
+
+	var err error
+	switch name {
+	case "FREQ":
+		switch value {
+		case "DAILY":
+			r.Frequency = RecurDaily
+		case "WEEKLY":
+			r.Frequency = RecurWeekly
+		case "MONTHLY":
+			r.Frequency = RecurMonthly
+		case "YEARLY":
+			r.Frequency = RecurYearly
+		default:
+			return fmt.Errorf("unsupported recurrence frequency; %q", value)
+		}
+	case "INTERVAL":
+		r.Interval, err = parseRecurrenceInt(value, 1, 0, false)
+	case "COUNT":
+		r.Count, err = parseRecurrenceInt(value, 1, 0, false)
+	case "UNTIL":
+		r.Until, err = parseRecurrenceUntil(value)
+	case "BYMONTH":
+		var months []int
+		months, err = parseRecurrenceInts(value, 12, false)
+		for _, month := range months {
+			r.ByMonth = append(r.ByMonth, time.Month(month))
+		}
+	case "BYWEEKNO":
+		r.ByWeekNo, err = parseRecurrenceInts(value, 53, true)
+	case "BYYEARDAY":
+		r.ByYearDay, err = parseRecurrenceInts(value, 366, true)
+	case "BYMONTHDAY":
+		r.ByMonthDay, err = parseRecurrenceInts(value, 31, true)
+	case "BYDAY":
+		r.ByDay, err = parseRecurrenceWeekdays(value)
+	case "BYSETPOS":
+		r.BySetPos, err = parseRecurrenceInts(value, 366, true)
+	case "WKST":
+		var wkst RecurrenceWeekday
+		wkst, err = parseRecurrenceWeekday(value)
+		if err == nil && wkst.Ordinal != 0 {
+			return invalid
+		}
+		r.WeekStart = wkst.Weekday
+	default:
+		return fmt.Errorf("unsupported recurrence rule part; %q", name)
+	}
+
+	if err != nil {
+		return invalid
+	}
+	return nil
+}
+
+func parseRecurrenceInt(s string, minimum, maximum int, signed bool) (int, error) {
+	if !signed && (strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+")) {
+		return 0, fmt.Errorf("invalid integer; %q", s)
+	}
+
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+
+	magnitude := value
+	if magnitude < 0 {
+		magnitude = -magnitude
+	}
+	if magnitude < minimum || (maximum > 0 && magnitude > maximum) {
+		return 0, fmt.Errorf("integer out of range; %q", s)
+	}
+	return value, nil
+}
+
+func parseRecurrenceInts(s string, maximum int, signed bool) ([]int, error) {
+	parts := strings.Split(s, ",")
+	values := make([]int, len(parts))
+	for i, part := range parts {
+		value, err := parseRecurrenceInt(part, 1, maximum, signed)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func parseRecurrenceUntil(s string) (NullDate, error) {
+	// NOTE: Only the date portion of a date-time (e.g. 20241231T235959Z) is
+	//       used.
+	datePart, _, _ := strings.Cut(s, "T")
+	t, err := time.Parse("20060102", datePart)
+	if err != nil {
+		return NullDate{}, err
+	}
+
+	d := Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+	return NullDate{Date: d, Valid: true}, nil
+}
+
+func parseRecurrenceWeekdays(s string) ([]RecurrenceWeekday, error) {
+	parts := strings.Split(s, ",")
+	weekdays := make([]RecurrenceWeekday, len(parts))
+	for i, part := range parts {
+		rw, err := parseRecurrenceWeekday(part)
+		if err != nil {
+			return nil, err
+		}
+		weekdays[i] = rw
+	}
+	return weekdays, nil
+}
+
+func parseRecurrenceWeekday(s string) (RecurrenceWeekday, error) {
+	if len(s) < 2 {
+		return RecurrenceWeekday{}, fmt.Errorf("invalid weekday; %q", s)
+	}
+
+	split := len(s) - 2
+	rw := RecurrenceWeekday{}
+	if split > 0 {
+		ordinal, err := parseRecurrenceInt(s[:split], 1, 53, true)
+		if err != nil {
+			return RecurrenceWeekday{}, err
+		}
+		rw.Ordinal = ordinal
+	}
+
+	for i, abbreviation := range weekdayAbbreviations {
+		if s[split:] == abbreviation {
+			rw.Weekday = time.Weekday(i)
+			return rw, nil
+		}
+	}
+
+	return RecurrenceWeekday{}, fmt.Errorf("invalid weekday; %q", s)
+}
+
+// Validate checks that the rule parts are supported and are valid for the
+// frequency of the rule, following the restrictions in RFC 5545; e.g. BYWEEKNO
+// is only valid in a YEARLY rule.
+func (r RecurrenceRule) Validate() error {
+	switch r.Frequency {
+	case RecurDaily, RecurWeekly, RecurMonthly, RecurYearly:
+	default:
+		return fmt.Errorf("unsupported recurrence frequency; %s", r.Frequency)
+	}
+
+	if r.Interval < 0 || r.Count < 0 {
+		return fmt.Errorf("recurrence rule interval and count must not be negative; interval=%d, count=%d", r.Interval, r.Count)
+	}
+	if r.Count > 0 && r.Until.Valid {
+		return fmt.Errorf("recurrence rule cannot contain both COUNT and UNTIL")
+	}
+	if len(r.ByWeekNo) > 0 && r.Frequency != RecurYearly {
+		return fmt.Errorf("BYWEEKNO is only valid in a YEARLY recurrence rule; FREQ=%s", r.Frequency)
+	}
+	if len(r.ByYearDay) > 0 && r.Frequency != RecurYearly {
+		return fmt.Errorf("BYYEARDAY is only valid in a YEARLY recurrence rule; FREQ=%s", r.Frequency)
+	}
+	if len(r.ByMonthDay) > 0 && r.Frequency == RecurWeekly {
+		return fmt.Errorf("BYMONTHDAY is not valid in a WEEKLY recurrence rule")
+	}
+
+	for _, rw := range r.ByDay {
+		if rw.Ordinal == 0 {
+			continue
+		}
+		if r.Frequency != RecurMonthly && r.Frequency != RecurYearly {
+			return fmt.Errorf("BYDAY ordinals are only valid in a MONTHLY or YEARLY recurrence rule; BYDAY=%s", rw)
+		}
+		if r.Frequency == RecurYearly && len(r.ByWeekNo) > 0 {
+			return fmt.Errorf("BYDAY ordinals are not valid with BYWEEKNO; BYDAY=%s", rw)
+		}
+	}
+
+	if len(r.BySetPos) > 0 && len(r.ByMonth)+len(r.ByWeekNo)+len(r.ByYearDay)+len(r.ByMonthDay)+len(r.ByDay) == 0 {
+		return fmt.Errorf("BYSETPOS requires another BYxxx rule part")
+	}
+
+	return nil
+}
+
+// String implements `fmt.Stringer`; formats the recurrence rule as it would
+// appear after RRULE: in an iCalendar file, e.g. FREQ=MONTHLY;BYDAY=-1FR.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + r.Frequency.String()}
+	if r.Interval > 0 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until.Valid {
+		parts = append(parts, "UNTIL="+r.Until.Date.Format("20060102"))
+	}
+	if len(r.ByMonth) > 0 {
+		months := make([]int, len(r.ByMonth))
+		for i, month := range r.ByMonth {
+			months[i] = int(month)
+		}
+		parts = append(parts, "BYMONTH="+joinInts(months))
+	}
+	if len(r.ByWeekNo) > 0 {
+		parts = append(parts, "BYWEEKNO="+joinInts(r.ByWeekNo))
+	}
+	if len(r.ByYearDay) > 0 {
+		parts = append(parts, "BYYEARDAY="+joinInts(r.ByYearDay))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		weekdays := make([]string, len(r.ByDay))
+		for i, rw := range r.ByDay {
+			weekdays[i] = rw.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(weekdays, ","))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayAbbreviations[r.WeekStart])
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.Itoa(value)
+	}
+	return strings.Join(parts, ",")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r RecurrenceRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the recurrence rule as an
+// RFC 5545 RRULE string.
+func (r RecurrenceRule) MarshalJSON() ([]byte, error) {
+	s := r.String()
+	return json.Marshal(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (r *RecurrenceRule) UnmarshalText(data []byte) error {
+	parsed, err := ParseRecurrenceRule(string(data))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`; parses the recurrence rule as
+// an RFC 5545 RRULE string.
+func (r *RecurrenceRule) UnmarshalJSON(data []byte) error {
+	s := ""
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParseRecurrenceRule(s)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// RecurrenceIterator lazily produces the occurrences of a recurrence rule in
+// order. Use `RecurrenceRule{}.Iterator()` to create one.
+type RecurrenceIterator struct {
+	rule    RecurrenceRule
+	dtstart Date
+	period  int
+	pending []Date
+	emitted int
+	done    bool
+}
+
+// Iterator returns an iterator over the occurrences of the recurrence rule
+// starting from `dtstart` (DTSTART).
+//
+// As in RFC 5545, when none of BYWEEKNO, BYYEARDAY, BYMONTHDAY or BYDAY are
+// given, the missing values are taken from `dtstart`; e.g. FREQ=MONTHLY with
+// a `dtstart` of 2024-01-15 recurs on the 15th of each month. Note that
+// `dtstart` itself is only an occurrence if it matches the rule.
+func (r RecurrenceRule) Iterator(dtstart Date) (*RecurrenceIterator, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	rule := r
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+	if len(rule.ByWeekNo)+len(rule.ByYearDay)+len(rule.ByMonthDay)+len(rule.ByDay) == 0 {
+		switch rule.Frequency {
+		case RecurWeekly:
+			rule.ByDay = []RecurrenceWeekday{{Weekday: dtstart.Weekday()}}
+		case RecurMonthly:
+			rule.ByMonthDay = []int{dtstart.Day}
+		case RecurYearly:
+			if len(rule.ByMonth) == 0 {
+				rule.ByMonth = []time.Month{dtstart.Month}
+			}
+			rule.ByMonthDay = []int{dtstart.Day}
+		}
+	}
+
+	return &RecurrenceIterator{rule: rule, dtstart: dtstart}, nil
+}
+
+// Next returns the next occurrence of the recurrence rule. Once the rule is
+// exhausted (via COUNT, UNTIL or by passing the year 9999), this returns
+// false.
+func (ri *RecurrenceIterator) Next() (Date, bool) {
+	for len(ri.pending) == 0 {
+		if ri.done {
+			return Date{}, false
+		}
+		ri.pending = ri.nextPeriod()
+	}
+
+	d := ri.pending[0]
+	ri.pending = ri.pending[1:]
+	if ri.rule.Until.Valid && d.After(ri.rule.Until.Date) {
+		ri.stop()
+		return Date{}, false
+	}
+
+	ri.emitted++
+	if ri.rule.Count > 0 && ri.emitted >= ri.rule.Count {
+		ri.stop()
+	}
+	return d, true
+}
+
+func (ri *RecurrenceIterator) stop() {
+	ri.done = true
+	ri.pending = nil
+}
+
+// nextPeriod returns the occurrences (on or after `dtstart`) in the next
+// period (i.e. day, week, month or year) of the rule.
+func (ri *RecurrenceIterator) nextPeriod() []Date {
+	r := ri.rule
+	k := ri.period
+	ri.period++
+
+	var start Date
+	var length, year int
+	switch r.Frequency {
+	case RecurDaily:
+		start = ri.dtstart.AddDays(k * r.Interval)
+		length = 1
+	case RecurWeekly:
+		start = weekStart(ri.dtstart, r.WeekStart).AddDays(7 * k * r.Interval)
+		length = 7
+	case RecurMonthly:
+		start = ri.dtstart.MonthStart().AddMonths(k * r.Interval)
+		length = daysIn(start.Month, start.Year)
+	default:
+		year = ri.dtstart.Year + k*r.Interval
+		start = Date{Year: year, Month: time.January, Day: 1}
+		length = int(daysInYear(year))
+		// NOTE: With BYWEEKNO, the period is the week-numbering year, which
+		//       runs from the start of week 1 to the start of week 1 of the
+		//       following year.
+		if len(r.ByWeekNo) > 0 {
+			start = weekYearStart(year, r.WeekStart)
+			length = int(weekYearStart(year+1, r.WeekStart).Sub(start))
+		}
+	}
+
+	var matched []Date
+	d := start
+	for i := 0; i < length; i++ {
+		if d.Year > maxRecurrenceYear {
+			ri.done = true
+			break
+		}
+		if ri.matches(d, year) {
+			matched = append(matched, d)
+		}
+		d = nextDay(d)
+	}
+
+	if len(r.BySetPos) > 0 {
+		matched = selectSetPositions(matched, r.BySetPos)
+	}
+
+	occurrences := matched[:0]
+	for _, m := range matched {
+		if !m.Before(ri.dtstart) {
+			occurrences = append(occurrences, m)
+		}
+	}
+	return occurrences
+}
+
+// matches determines if a date satisfies every BYxxx rule part. For a YEARLY
+// rule, `year` is the year of the period containing `d`.
+func (ri *RecurrenceIterator) matches(d Date, year int) bool {
+	r := ri.rule
+	if len(r.ByMonth) > 0 && !containsMonth(r.ByMonth, d.Month) {
+		return false
+	}
+	if len(r.ByWeekNo) > 0 {
+		week, weeks := weekNumber(d, year, r.WeekStart)
+		if !matchesIndex(r.ByWeekNo, week, weeks) {
+			return false
+		}
+	}
+	if len(r.ByYearDay) > 0 && !matchesIndex(r.ByYearDay, d.YearDay(), int(daysInYear(d.Year))) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 && !matchesIndex(r.ByMonthDay, d.Day, daysIn(d.Month, d.Year)) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !ri.matchesWeekday(d) {
+		return false
+	}
+	return true
+}
+
+// matchesWeekday determines if a date satisfies the BYDAY rule part. An
+// ordinal is relative to the month for a MONTHLY rule (or a YEARLY rule with
+// BYMONTH) and relative to the year for a YEARLY rule.
+func (ri *RecurrenceIterator) matchesWeekday(d Date) bool {
+	weekday := d.Weekday()
+	index, length := d.Day, daysIn(d.Month, d.Year)
+	if ri.rule.Frequency == RecurYearly && len(ri.rule.ByMonth) == 0 {
+		index, length = d.YearDay(), int(daysInYear(d.Year))
+	}
+
+	for _, rw := range ri.rule.ByDay {
+		if rw.Weekday != weekday {
+			continue
+		}
+		if rw.Ordinal == 0 {
+			return true
+		}
+		if rw.Ordinal > 0 && rw.Ordinal == (index-1)/7+1 {
+			return true
+		}
+		if rw.Ordinal < 0 && -rw.Ordinal == (length-index)/7+1 {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesIndex determines if a 1-based index (e.g. a day of the month) is
+// in a list of values, where negative values count back from the end.
+func matchesIndex(values []int, index, length int) bool {
+	for _, value := range values {
+		if value == index || value == index-length-1 {
+			return true
+		}
+	}
+	return false
+}
+
+func containsMonth(months []time.Month, month time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+func selectSetPositions(matched []Date, positions []int) []Date {
+	selected := map[Date]struct{}{}
+	for _, position := range positions {
+		i := position - 1
+		if position < 0 {
+			i = len(matched) + position
+		}
+		if i >= 0 && i < len(matched) {
+			selected[matched[i]] = struct{}{}
+		}
+	}
+
+	result := make([]Date, 0, len(selected))
+	for d := range selected {
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Before(result[j])
+	})
+	return result
+}
+
+// weekStart returns the first day of the week (starting on `wkst`) that
+// contains `d`.
+func weekStart(d Date, wkst time.Weekday) Date {
+	offset := (int(d.Weekday()) - int(wkst) + 7) % 7
+	return d.AddDays(-offset)
+}
+
+// weekYearStart returns the first day of week 1 of `year`, for weeks starting
+// on `wkst`. As in ISO 8601, week 1 is the first week containing at least 4
+// days of the year.
+func weekYearStart(year int, wkst time.Weekday) Date {
+	return weekStart(Date{Year: year, Month: time.January, Day: 4}, wkst)
+}
+
+// weekNumber returns the week number of `d` relative to the week-numbering
+// year `year` and the number of weeks in that year, for weeks starting on
+// `wkst`.
+func weekNumber(d Date, year int, wkst time.Weekday) (week, weeks int) {
+	first := weekYearStart(year, wkst)
+	next := weekYearStart(year+1, wkst)
+	return int(d.Sub(first))/7 + 1, int(next.Sub(first)) / 7
+}
+
+// nextDay returns the day after `d`, without converting to a `time.Time`.
+func nextDay(d Date) Date {
+	if d.Day < daysIn(d.Month, d.Year) {
+		return Date{Year: d.Year, Month: d.Month, Day: d.Day + 1}
+	}
+	if d.Month < time.December {
+		return Date{Year: d.Year, Month: d.Month + 1, Day: 1}
+	}
+	return Date{Year: d.Year + 1, Month: time.January, Day: 1}
+}
@@ -0,0 +1,332 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestRecurrenceRule_Iterator(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Rule     string
+		DTStart  string
+		Expected []string
+	}
+
+	cases := []testCase{
+		{
+			Rule:     "FREQ=DAILY;COUNT=5",
+			DTStart:  "1997-09-02",
+			Expected: []string{"1997-09-02", "1997-09-03", "1997-09-04", "1997-09-05", "1997-09-06"},
+		},
+		{
+			Rule:     "FREQ=DAILY;INTERVAL=10;COUNT=5",
+			DTStart:  "1997-09-02",
+			Expected: []string{"1997-09-02", "1997-09-12", "1997-09-22", "1997-10-02", "1997-10-12"},
+		},
+		{
+			Rule:     "FREQ=DAILY;BYMONTH=1;UNTIL=20000104",
+			DTStart:  "1999-12-30",
+			Expected: []string{"2000-01-01", "2000-01-02", "2000-01-03", "2000-01-04"},
+		},
+		{
+			Rule:     "FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;COUNT=8",
+			DTStart:  "1997-09-02",
+			Expected: []string{"1997-09-02", "1997-09-04", "1997-09-16", "1997-09-18", "1997-09-30", "1997-10-02", "1997-10-14", "1997-10-16"},
+		},
+		{
+			Rule:     "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			DTStart:  "1997-08-05",
+			Expected: []string{"1997-08-05", "1997-08-10", "1997-08-19", "1997-08-24"},
+		},
+		{
+			Rule:     "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			DTStart:  "1997-08-05",
+			Expected: []string{"1997-08-05", "1997-08-17", "1997-08-19", "1997-08-31"},
+		},
+		{
+			Rule:     "FREQ=MONTHLY;COUNT=6;BYDAY=1FR",
+			DTStart:  "1997-09-05",
+			Expected: []string{"1997-09-05", "1997-10-03", "1997-11-07", "1997-12-05", "1998-01-02", "1998-02-06"},
+		},
+		{
+			Rule:     "FREQ=MONTHLY;BYMONTHDAY=-3;COUNT=6",
+			DTStart:  "1997-09-28",
+			Expected: []string{"1997-09-28", "1997-10-29", "1997-11-28", "1997-12-29", "1998-01-29", "1998-02-26"},
+		},
+		{
+			Rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=6",
+			DTStart:  "1997-09-30",
+			Expected: []string{"1997-09-30", "1997-10-31", "1997-11-28", "1997-12-31", "1998-01-30", "1998-02-27"},
+		},
+		{
+			Rule:     "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=5",
+			DTStart:  "1997-09-02",
+			Expected: []string{"1998-02-13", "1998-03-13", "1998-11-13", "1999-08-13", "2000-10-13"},
+		},
+		{
+			Rule:     "FREQ=MONTHLY;COUNT=4",
+			DTStart:  "2024-01-31",
+			Expected: []string{"2024-01-31", "2024-03-31", "2024-05-31", "2024-07-31"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;COUNT=3",
+			DTStart:  "2024-02-29",
+			Expected: []string{"2024-02-29", "2028-02-29", "2032-02-29"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYDAY=20MO;COUNT=3",
+			DTStart:  "1997-05-19",
+			Expected: []string{"1997-05-19", "1998-05-18", "1999-05-17"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3",
+			DTStart:  "1997-05-12",
+			Expected: []string{"1997-05-12", "1998-05-11", "1999-05-17"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYWEEKNO=1;BYDAY=MO;COUNT=3",
+			DTStart:  "2024-01-01",
+			Expected: []string{"2024-01-01", "2024-12-30", "2025-12-29"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYWEEKNO=1;BYDAY=MO;INTERVAL=2;COUNT=3",
+			DTStart:  "2019-01-01",
+			Expected: []string{"2021-01-04", "2023-01-02", "2024-12-30"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYWEEKNO=-1;BYDAY=SU;COUNT=3",
+			DTStart:  "2024-01-01",
+			Expected: []string{"2024-12-29", "2025-12-28", "2027-01-03"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU;COUNT=2",
+			DTStart:  "2024-01-01",
+			Expected: []string{"2024-03-31", "2025-03-30"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYYEARDAY=1,100,200;INTERVAL=3;COUNT=7",
+			DTStart:  "1997-01-01",
+			Expected: []string{"1997-01-01", "1997-04-10", "1997-07-19", "2000-01-01", "2000-04-09", "2000-07-18", "2003-01-01"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYYEARDAY=-1;COUNT=2",
+			DTStart:  "2024-01-01",
+			Expected: []string{"2024-12-31", "2025-12-31"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8;COUNT=3",
+			DTStart:  "1996-11-05",
+			Expected: []string{"1996-11-05", "2000-11-07", "2004-11-02"},
+		},
+		{
+			Rule:     "FREQ=MONTHLY;BYDAY=TU,WE,TH;BYSETPOS=3;COUNT=3",
+			DTStart:  "1997-09-04",
+			Expected: []string{"1997-09-04", "1997-10-07", "1997-11-06"},
+		},
+		{
+			Rule:     "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			DTStart:  "2024-01-01",
+			Expected: nil,
+		},
+		{
+			Rule:     "FREQ=YEARLY;INTERVAL=1000",
+			DTStart:  "2024-07-04",
+			Expected: []string{"2024-07-04", "3024-07-04", "4024-07-04", "5024-07-04", "6024-07-04", "7024-07-04", "8024-07-04", "9024-07-04"},
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Rule, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			r, err := date.ParseRecurrenceRule(tc.Rule)
+			assert.Nil(err)
+			it, err := r.Iterator(mustDate(assert, tc.DTStart))
+			assert.Nil(err)
+
+			var computed []string
+			for {
+				d, ok := it.Next()
+				if !ok {
+					break
+				}
+				computed = append(computed, d.String())
+			}
+			assert.Equal(tc.Expected, computed)
+
+			_, ok := it.Next()
+			assert.False(ok)
+		})
+	}
+}
+
+func TestRecurrenceRule_Iterator_Lazy(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	// Last business day of every month, forever
+	r := date.RecurrenceRule{
+		Frequency: date.RecurMonthly,
+		ByDay: []date.RecurrenceWeekday{
+			{Weekday: time.Monday},
+			{Weekday: time.Tuesday},
+			{Weekday: time.Wednesday},
+			{Weekday: time.Thursday},
+			{Weekday: time.Friday},
+		},
+		BySetPos:  []int{-1},
+		WeekStart: time.Monday,
+	}
+	it, err := r.Iterator(mustDate(assert, "2024-01-01"))
+	assert.Nil(err)
+
+	computed := make([]string, 4)
+	for i := range computed {
+		d, ok := it.Next()
+		assert.True(ok)
+		computed[i] = d.String()
+	}
+	assert.Equal([]string{"2024-01-31", "2024-02-29", "2024-03-29", "2024-04-30"}, computed)
+}
+
+func TestParseRecurrenceRule(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input    string
+		Expected string
+		Error    string
+	}
+
+	cases := []testCase{
+		{Input: "FREQ=DAILY", Expected: "FREQ=DAILY"},
+		{Input: "RRULE:FREQ=WEEKLY;INTERVAL=1", Expected: "FREQ=WEEKLY;INTERVAL=1"},
+		{Input: "freq=monthly;byday=-1fr", Expected: "FREQ=MONTHLY;BYDAY=-1FR"},
+		{Input: "FREQ=MONTHLY;BYDAY=+2TU", Expected: "FREQ=MONTHLY;BYDAY=2TU"},
+		{Input: "WKST=SU;BYDAY=TU,TH;FREQ=WEEKLY;INTERVAL=2;COUNT=8", Expected: "FREQ=WEEKLY;INTERVAL=2;COUNT=8;BYDAY=TU,TH;WKST=SU"},
+		{Input: "FREQ=YEARLY;UNTIL=20241231", Expected: "FREQ=YEARLY;UNTIL=20241231"},
+		{Input: "FREQ=YEARLY;UNTIL=20241231T235959Z", Expected: "FREQ=YEARLY;UNTIL=20241231"},
+		{
+			Input:    "FREQ=YEARLY;BYMONTH=1,7;BYWEEKNO=-1;BYYEARDAY=100;BYMONTHDAY=1,-1;BYDAY=MO;BYSETPOS=1,-1;WKST=MO",
+			Expected: "FREQ=YEARLY;BYMONTH=1,7;BYWEEKNO=-1;BYYEARDAY=100;BYMONTHDAY=1,-1;BYDAY=MO;BYSETPOS=1,-1",
+		},
+		{Input: "", Error: `invalid recurrence rule; ""`},
+		{Input: "FREQ", Error: `invalid recurrence rule; "FREQ"`},
+		{Input: "FREQ=DAILY;", Error: `invalid recurrence rule; "FREQ=DAILY;"`},
+		{Input: "COUNT=1", Error: `recurrence rule requires FREQ; "COUNT=1"`},
+		{Input: "FREQ=HOURLY", Error: `unsupported recurrence frequency; "HOURLY"`},
+		{Input: "FREQ=DAILY;BYHOUR=9", Error: `unsupported recurrence rule part; "BYHOUR"`},
+		{Input: "FREQ=DAILY;FREQ=DAILY", Error: `duplicate recurrence rule part; "FREQ=DAILY"`},
+		{Input: "FREQ=DAILY;INTERVAL=0", Error: `invalid recurrence rule part; "INTERVAL=0"`},
+		{Input: "FREQ=DAILY;COUNT=-1", Error: `invalid recurrence rule part; "COUNT=-1"`},
+		{Input: "FREQ=DAILY;UNTIL=2024-12-31", Error: `invalid recurrence rule part; "UNTIL=2024-12-31"`},
+		{Input: "FREQ=MONTHLY;BYMONTHDAY=0", Error: `invalid recurrence rule part; "BYMONTHDAY=0"`},
+		{Input: "FREQ=MONTHLY;BYMONTHDAY=32", Error: `invalid recurrence rule part; "BYMONTHDAY=32"`},
+		{Input: "FREQ=YEARLY;BYMONTH=13", Error: `invalid recurrence rule part; "BYMONTH=13"`},
+		{Input: "FREQ=YEARLY;BYMONTH=-1", Error: `invalid recurrence rule part; "BYMONTH=-1"`},
+		{Input: "FREQ=MONTHLY;BYDAY=XX", Error: `invalid recurrence rule part; "BYDAY=XX"`},
+		{Input: "FREQ=MONTHLY;BYDAY=0MO", Error: `invalid recurrence rule part; "BYDAY=0MO"`},
+		{Input: "FREQ=WEEKLY;WKST=1MO", Error: `invalid recurrence rule part; "WKST=1MO"`},
+		{Input: "FREQ=DAILY;COUNT=2;UNTIL=20241231", Error: "recurrence rule cannot contain both COUNT and UNTIL"},
+		{Input: "FREQ=MONTHLY;BYWEEKNO=1", Error: "BYWEEKNO is only valid in a YEARLY recurrence rule; FREQ=MONTHLY"},
+		{Input: "FREQ=MONTHLY;BYYEARDAY=1", Error: "BYYEARDAY is only valid in a YEARLY recurrence rule; FREQ=MONTHLY"},
+		{Input: "FREQ=WEEKLY;BYMONTHDAY=1", Error: "BYMONTHDAY is not valid in a WEEKLY recurrence rule"},
+		{Input: "FREQ=WEEKLY;BYDAY=1MO", Error: "BYDAY ordinals are only valid in a MONTHLY or YEARLY recurrence rule; BYDAY=1MO"},
+		{Input: "FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO", Error: "BYDAY ordinals are not valid with BYWEEKNO; BYDAY=1MO"},
+		{Input: "FREQ=MONTHLY;BYSETPOS=1", Error: "BYSETPOS requires another BYxxx rule part"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			r, err := date.ParseRecurrenceRule(tc.Input)
+			if tc.Error != "" {
+				assert.NotNil(err)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Expected, r.String())
+
+			// Round trip
+			again, err := date.ParseRecurrenceRule(r.String())
+			assert.Nil(err)
+			assert.Equal(r, again)
+		})
+	}
+}
+
+func TestRecurrenceRule_Iterator_Invalid(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	it, err := date.RecurrenceRule{}.Iterator(mustDate(assert, "2024-01-01"))
+	assert.Nil(it)
+	assert.NotNil(err)
+	assert.Equal("unsupported recurrence frequency; RecurrenceFrequency(0)", fmt.Sprintf("%v", err))
+}
+
+func TestRecurrenceRule_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	type event struct {
+		Recurrence date.RecurrenceRule `json:"recurrence"`
+	}
+
+	r, err := date.ParseRecurrenceRule("FREQ=MONTHLY;BYDAY=-1FR")
+	assert.Nil(err)
+	asBytes, err := json.Marshal(event{Recurrence: r})
+	assert.Nil(err)
+	assert.Equal(`{"recurrence":"FREQ=MONTHLY;BYDAY=-1FR"}`, string(asBytes))
+
+	parsed := event{}
+	err = json.Unmarshal(asBytes, &parsed)
+	assert.Nil(err)
+	assert.Equal(r, parsed.Recurrence)
+
+	err = json.Unmarshal([]byte(`{"recurrence":"FREQ=HOURLY"}`), &parsed)
+	assert.NotNil(err)
+	assert.Equal(`unsupported recurrence frequency; "HOURLY"`, fmt.Sprintf("%v", err))
+
+	asBytes, err = r.MarshalText()
+	assert.Nil(err)
+	assert.Equal("FREQ=MONTHLY;BYDAY=-1FR", string(asBytes))
+
+	r = date.RecurrenceRule{}
+	err = r.UnmarshalText([]byte("FREQ=DAILY;COUNT=3"))
+	assert.Nil(err)
+	assert.Equal(date.RecurrenceRule{Frequency: date.RecurDaily, Count: 3, WeekStart: time.Monday}, r)
+}
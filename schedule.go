@@ -0,0 +1,357 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"fmt"
+)
+
+// Frequency is the length of a regular period in a payment schedule.
+type Frequency int
+
+const (
+	// Weekly is a period of 7 days.
+	Weekly Frequency = iota + 1
+	// Biweekly is a period of 14 days.
+	Biweekly
+	// Monthly is a period of 1 month.
+	Monthly
+	// Quarterly is a period of 3 months.
+	Quarterly
+	// Semiannual is a period of 6 months.
+	Semiannual
+	// Annual is a period of 12 months.
+	Annual
+)
+
+// String implements `fmt.Stringer`.
+func (f Frequency) String() string {
+	switch f {
+	case Weekly:
+		return "Weekly"
+	case Biweekly:
+		return "Biweekly"
+	case Monthly:
+		return "Monthly"
+	case Quarterly:
+		return "Quarterly"
+	case Semiannual:
+		return "Semiannual"
+	case Annual:
+		return "Annual"
+	default:
+		return fmt.Sprintf("Frequency(%d)", int(f))
+	}
+}
+
+// units returns the number of days in a period for day-based frequencies
+// and the number of months in a period for month-based frequencies.
+func (f Frequency) units() (days int, months int) {
+	switch f {
+	case Weekly:
+		return 7, 0
+	case Biweekly:
+		return 14, 0
+	case Monthly:
+		return 0, 1
+	case Quarterly:
+		return 0, 3
+	case Semiannual:
+		return 0, 6
+	case Annual:
+		return 0, 12
+	default:
+		return 0, 0
+	}
+}
+
+// RollDirection determines which end of a schedule regular periods are
+// generated from.
+type RollDirection int
+
+const (
+	// RollBackward generates regular periods backward from the termination
+	// date; any stub period falls at the front of the schedule. This is the
+	// market standard for most loans and swaps.
+	RollBackward RollDirection = iota
+	// RollForward generates regular periods forward from the effective date;
+	// any stub period falls at the back of the schedule.
+	RollForward
+)
+
+// StubLength determines how an irregular (stub) period is handled when the
+// schedule does not divide evenly into regular periods.
+type StubLength int
+
+const (
+	// StubShort leaves the stub as its own period, shorter than a regular
+	// period.
+	StubShort StubLength = iota
+	// StubLong combines the stub with the adjacent regular period, creating a
+	// single period longer than a regular period.
+	StubLong
+)
+
+// ScheduleConfig helps customize the behavior of `GenerateSchedule()`.
+type ScheduleConfig struct {
+	Direction        RollDirection
+	FrontStub        StubLength
+	BackStub         StubLength
+	FirstRegularDate NullDate
+	LastRegularDate  NullDate
+	EndOfMonth       bool
+	Calendar         BusinessCalendar
+	Convention       BusinessDayConvention
+}
+
+// ScheduleOption defines a function that will be applied to a schedule
+// config.
+type ScheduleOption func(*ScheduleConfig)
+
+// OptScheduleDirection returns an option that sets the roll direction on a
+// schedule config. Rolling backward (the default) places a stub at the front
+// of the schedule and rolling forward places a stub at the back.
+func OptScheduleDirection(direction RollDirection) ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.Direction = direction
+	}
+}
+
+// OptScheduleStub returns an option that sets the stub length for both the
+// front and back stubs on a schedule config.
+func OptScheduleStub(stub StubLength) ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.FrontStub = stub
+		sc.BackStub = stub
+	}
+}
+
+// OptScheduleFrontStub returns an option that sets the stub length for a stub
+// at the front of the schedule on a schedule config.
+func OptScheduleFrontStub(stub StubLength) ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.FrontStub = stub
+	}
+}
+
+// OptScheduleBackStub returns an option that sets the stub length for a stub
+// at the back of the schedule on a schedule config.
+func OptScheduleBackStub(stub StubLength) ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.BackStub = stub
+	}
+}
+
+// OptScheduleFirstRegularDate returns an option that sets the start of the
+// first regular period on a schedule config. If this is after the effective
+// date, the schedule has an explicit front stub ending on this date.
+func OptScheduleFirstRegularDate(d Date) ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.FirstRegularDate = NullDate{Date: d, Valid: true}
+	}
+}
+
+// OptScheduleLastRegularDate returns an option that sets the end of the last
+// regular period (i.e. the next-to-last date in the schedule) on a schedule
+// config. If this is before the termination date, the schedule has an
+// explicit back stub starting on this date.
+func OptScheduleLastRegularDate(d Date) ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.LastRegularDate = NullDate{Date: d, Valid: true}
+	}
+}
+
+// OptScheduleEndOfMonth returns an option that enables the end-of-month rule
+// on a schedule config. When enabled, and the date periods are generated from
+// is the last day of a month, every generated date (for month-based
+// frequencies) is the last day of its month.
+func OptScheduleEndOfMonth() ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.EndOfMonth = true
+	}
+}
+
+// OptScheduleAdjustment returns an option that sets the business calendar and
+// business day convention used to produce adjusted dates on a schedule
+// config.
+func OptScheduleAdjustment(calendar BusinessCalendar, convention BusinessDayConvention) ScheduleOption {
+	return func(sc *ScheduleConfig) {
+		sc.Calendar = calendar
+		sc.Convention = convention
+	}
+}
+
+// SchedulePeriod is a single period in a payment schedule. The unadjusted
+// range is computed purely from the schedule rules and the adjusted range has
+// both boundaries rolled to business days.
+type SchedulePeriod struct {
+	Unadjusted DateRange
+	Adjusted   DateRange
+}
+
+// GenerateSchedule returns the periods of a payment schedule from the
+// effective date to the termination date with regular periods of the given
+// frequency.
+//
+// Regular period boundaries are computed directly from the date they are
+// generated from (rather than by repeatedly adding to the previous
+// boundary), so that clamping in `AddMonths()` does not accumulate; e.g.
+// monthly periods generated from 2024-01-31 end on 2024-02-29, 2024-03-31,
+// 2024-04-30, and so on.
+//
+// Explicit stubs can be requested at either end (or both ends) of the
+// schedule via `OptScheduleFirstRegularDate()` and
+// `OptScheduleLastRegularDate()`; regular periods are then generated between
+// those dates (in the configured roll direction) and the explicit stubs are
+// short or long according to `OptScheduleFrontStub()` and
+// `OptScheduleBackStub()`.
+//
+// If no business calendar is provided via `OptScheduleAdjustment()`, the
+// adjusted ranges are the same as the unadjusted ranges.
+func GenerateSchedule(effective, termination Date, frequency Frequency, opts ...ScheduleOption) ([]SchedulePeriod, error) {
+	sc := ScheduleConfig{Direction: RollBackward, FrontStub: StubShort, BackStub: StubShort}
+	for _, opt := range opts {
+		opt(&sc)
+	}
+
+	if effective.IsInfinite() || termination.IsInfinite() {
+		return nil, fmt.Errorf("schedule dates must be finite; effective=%s, termination=%s", effective, termination)
+	}
+	if !effective.Before(termination) {
+		return nil, fmt.Errorf("schedule effective date must be before termination date; effective=%s, termination=%s", effective, termination)
+	}
+
+	days, months := frequency.units()
+	if days == 0 && months == 0 {
+		return nil, fmt.Errorf("unsupported schedule frequency; %s", frequency)
+	}
+
+	regularStart := effective
+	if sc.FirstRegularDate.Valid {
+		regularStart = sc.FirstRegularDate.Date
+		if regularStart.Before(effective) || !regularStart.Before(termination) {
+			return nil, fmt.Errorf("schedule first regular date must be within the schedule; effective=%s, first=%s, termination=%s", effective, regularStart, termination)
+		}
+	}
+	regularEnd := termination
+	if sc.LastRegularDate.Valid {
+		regularEnd = sc.LastRegularDate.Date
+		if !effective.Before(regularEnd) || termination.Before(regularEnd) {
+			return nil, fmt.Errorf("schedule last regular date must be within the schedule; effective=%s, last=%s, termination=%s", effective, regularEnd, termination)
+		}
+	}
+	if !regularStart.Before(regularEnd) {
+		return nil, fmt.Errorf("schedule first regular date must be before last regular date; first=%s, last=%s", regularStart, regularEnd)
+	}
+
+	var boundaries []Date
+	switch sc.Direction {
+	case RollForward:
+		boundaries = rollForward(regularStart, regularEnd, days, months, sc.EndOfMonth, sc.BackStub)
+	case RollBackward:
+		boundaries = rollBackward(regularStart, regularEnd, days, months, sc.EndOfMonth, sc.FrontStub)
+	default:
+		return nil, fmt.Errorf("unsupported schedule roll direction; %d", sc.Direction)
+	}
+
+	// NOTE: An explicit stub is either its own period (short) or merged into
+	//       the adjacent regular period (long).
+	if regularStart.After(effective) {
+		if sc.FrontStub == StubLong {
+			boundaries[0] = effective
+		} else {
+			boundaries = append([]Date{effective}, boundaries...)
+		}
+	}
+	if regularEnd.Before(termination) {
+		if sc.BackStub == StubLong {
+			boundaries[len(boundaries)-1] = termination
+		} else {
+			boundaries = append(boundaries, termination)
+		}
+	}
+
+	periods := make([]SchedulePeriod, 0, len(boundaries)-1)
+	for i := 1; i < len(boundaries); i++ {
+		unadjusted := NewDateRange(boundaries[i-1], boundaries[i])
+		adjusted := unadjusted
+		if sc.Calendar != nil {
+			adjusted = NewDateRange(
+				Adjust(unadjusted.Start, sc.Calendar, sc.Convention),
+				Adjust(unadjusted.End, sc.Calendar, sc.Convention),
+			)
+		}
+		periods = append(periods, SchedulePeriod{Unadjusted: unadjusted, Adjusted: adjusted})
+	}
+
+	return periods, nil
+}
+
+// scheduleDate returns the `k`th regular boundary from the anchor date.
+func scheduleDate(anchor Date, k, days, months int, endOfMonth bool) Date {
+	if months == 0 {
+		return anchor.AddDays(k * days)
+	}
+
+	d := anchor.AddMonths(k * months)
+	if endOfMonth && anchor.Equal(anchor.MonthEnd()) {
+		return d.MonthEnd()
+	}
+	return d
+}
+
+func rollForward(effective, termination Date, days, months int, endOfMonth bool, stub StubLength) []Date {
+	boundaries := []Date{effective}
+	for k := 1; ; k++ {
+		d := scheduleDate(effective, k, days, months, endOfMonth)
+		if !d.Before(termination) {
+			break
+		}
+		boundaries = append(boundaries, d)
+	}
+
+	// NOTE: A stub exists if the last regular boundary does not land exactly
+	//       on the termination date. A long stub is merged into the previous
+	//       regular period (if there is one).
+	hasStub := !scheduleDate(effective, len(boundaries), days, months, endOfMonth).Equal(termination)
+	if hasStub && stub == StubLong && len(boundaries) > 1 {
+		boundaries = boundaries[:len(boundaries)-1]
+	}
+
+	return append(boundaries, termination)
+}
+
+func rollBackward(effective, termination Date, days, months int, endOfMonth bool, stub StubLength) []Date {
+	reversed := []Date{termination}
+	for k := 1; ; k++ {
+		d := scheduleDate(termination, -k, days, months, endOfMonth)
+		if !effective.Before(d) {
+			break
+		}
+		reversed = append(reversed, d)
+	}
+
+	hasStub := !scheduleDate(termination, -len(reversed), days, months, endOfMonth).Equal(effective)
+	if hasStub && stub == StubLong && len(reversed) > 1 {
+		reversed = reversed[:len(reversed)-1]
+	}
+	reversed = append(reversed, effective)
+
+	boundaries := make([]Date, len(reversed))
+	for i, d := range reversed {
+		boundaries[len(reversed)-1-i] = d
+	}
+	return boundaries
+}
@@ -0,0 +1,335 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestGenerateSchedule(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Name        string
+		Effective   string
+		Termination string
+		Frequency   date.Frequency
+		Options     []date.ScheduleOption
+		Expected    []string
+	}
+
+	cases := []testCase{
+		{
+			Name:        "Monthly, no stub",
+			Effective:   "2024-01-15",
+			Termination: "2024-05-15",
+			Frequency:   date.Monthly,
+			Expected:    []string{"[2024-01-15,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-04-15)", "[2024-04-15,2024-05-15)"},
+		},
+		{
+			Name:        "Monthly, short front stub",
+			Effective:   "2024-01-01",
+			Termination: "2024-04-15",
+			Frequency:   date.Monthly,
+			Expected:    []string{"[2024-01-01,2024-01-15)", "[2024-01-15,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-04-15)"},
+		},
+		{
+			Name:        "Monthly, long front stub",
+			Effective:   "2024-01-01",
+			Termination: "2024-04-15",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleStub(date.StubLong)},
+			Expected:    []string{"[2024-01-01,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-04-15)"},
+		},
+		{
+			Name:        "Monthly, short back stub",
+			Effective:   "2024-01-15",
+			Termination: "2024-04-01",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward)},
+			Expected:    []string{"[2024-01-15,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-04-01)"},
+		},
+		{
+			Name:        "Monthly, long back stub",
+			Effective:   "2024-01-15",
+			Termination: "2024-04-01",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward), date.OptScheduleStub(date.StubLong)},
+			Expected:    []string{"[2024-01-15,2024-02-15)", "[2024-02-15,2024-04-01)"},
+		},
+		{
+			Name:        "Long stub with a single period",
+			Effective:   "2024-01-15",
+			Termination: "2024-02-01",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleStub(date.StubLong)},
+			Expected:    []string{"[2024-01-15,2024-02-01)"},
+		},
+		{
+			Name:        "Monthly, forward from month end without EOM",
+			Effective:   "2024-01-31",
+			Termination: "2024-05-31",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward)},
+			Expected:    []string{"[2024-01-31,2024-02-29)", "[2024-02-29,2024-03-31)", "[2024-03-31,2024-04-30)", "[2024-04-30,2024-05-31)"},
+		},
+		{
+			Name:        "Monthly, backward from short month end without EOM",
+			Effective:   "2024-01-30",
+			Termination: "2024-04-30",
+			Frequency:   date.Monthly,
+			Expected:    []string{"[2024-01-30,2024-02-29)", "[2024-02-29,2024-03-30)", "[2024-03-30,2024-04-30)"},
+		},
+		{
+			Name:        "Monthly, backward from short month end with EOM",
+			Effective:   "2024-01-31",
+			Termination: "2024-04-30",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleEndOfMonth()},
+			Expected:    []string{"[2024-01-31,2024-02-29)", "[2024-02-29,2024-03-31)", "[2024-03-31,2024-04-30)"},
+		},
+		{
+			Name:        "Quarterly, forward from February with EOM",
+			Effective:   "2023-02-28",
+			Termination: "2024-02-29",
+			Frequency:   date.Quarterly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward), date.OptScheduleEndOfMonth()},
+			Expected:    []string{"[2023-02-28,2023-05-31)", "[2023-05-31,2023-08-31)", "[2023-08-31,2023-11-30)", "[2023-11-30,2024-02-29)"},
+		},
+		{
+			Name:        "Quarterly, forward from February without EOM",
+			Effective:   "2023-02-28",
+			Termination: "2024-02-29",
+			Frequency:   date.Quarterly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward)},
+			Expected:    []string{"[2023-02-28,2023-05-28)", "[2023-05-28,2023-08-28)", "[2023-08-28,2023-11-28)", "[2023-11-28,2024-02-28)", "[2024-02-28,2024-02-29)"},
+		},
+		{
+			Name:        "Semiannual",
+			Effective:   "2024-03-15",
+			Termination: "2026-03-15",
+			Frequency:   date.Semiannual,
+			Expected:    []string{"[2024-03-15,2024-09-15)", "[2024-09-15,2025-03-15)", "[2025-03-15,2025-09-15)", "[2025-09-15,2026-03-15)"},
+		},
+		{
+			Name:        "Annual, short front stub",
+			Effective:   "2024-03-15",
+			Termination: "2026-01-01",
+			Frequency:   date.Annual,
+			Expected:    []string{"[2024-03-15,2025-01-01)", "[2025-01-01,2026-01-01)"},
+		},
+		{
+			Name:        "Weekly",
+			Effective:   "2024-01-01",
+			Termination: "2024-01-25",
+			Frequency:   date.Weekly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward)},
+			Expected:    []string{"[2024-01-01,2024-01-08)", "[2024-01-08,2024-01-15)", "[2024-01-15,2024-01-22)", "[2024-01-22,2024-01-25)"},
+		},
+		{
+			Name:        "Biweekly",
+			Effective:   "2024-01-01",
+			Termination: "2024-01-25",
+			Frequency:   date.Biweekly,
+			Expected:    []string{"[2024-01-01,2024-01-11)", "[2024-01-11,2024-01-25)"},
+		},
+		{
+			Name:        "Monthly, explicit back stub rolling backward",
+			Effective:   "2024-01-15",
+			Termination: "2024-05-01",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleLastRegularDate(date.NewDate(2024, time.April, 15))},
+			Expected:    []string{"[2024-01-15,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-04-15)", "[2024-04-15,2024-05-01)"},
+		},
+		{
+			Name:        "Monthly, explicit front stub rolling forward",
+			Effective:   "2024-01-01",
+			Termination: "2024-04-15",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward), date.OptScheduleFirstRegularDate(date.NewDate(2024, time.January, 15))},
+			Expected:    []string{"[2024-01-01,2024-01-15)", "[2024-01-15,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-04-15)"},
+		},
+		{
+			Name:        "Monthly, explicit front and back stubs",
+			Effective:   "2024-01-01",
+			Termination: "2024-05-01",
+			Frequency:   date.Monthly,
+			Options: []date.ScheduleOption{
+				date.OptScheduleFirstRegularDate(date.NewDate(2024, time.January, 15)),
+				date.OptScheduleLastRegularDate(date.NewDate(2024, time.April, 15)),
+			},
+			Expected: []string{"[2024-01-01,2024-01-15)", "[2024-01-15,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-04-15)", "[2024-04-15,2024-05-01)"},
+		},
+		{
+			Name:        "Monthly, explicit long front and back stubs",
+			Effective:   "2024-01-01",
+			Termination: "2024-05-01",
+			Frequency:   date.Monthly,
+			Options: []date.ScheduleOption{
+				date.OptScheduleFirstRegularDate(date.NewDate(2024, time.January, 15)),
+				date.OptScheduleLastRegularDate(date.NewDate(2024, time.April, 15)),
+				date.OptScheduleFrontStub(date.StubLong),
+				date.OptScheduleBackStub(date.StubLong),
+			},
+			Expected: []string{"[2024-01-01,2024-02-15)", "[2024-02-15,2024-03-15)", "[2024-03-15,2024-05-01)"},
+		},
+		{
+			Name:        "Monthly, long back stub only",
+			Effective:   "2024-01-01",
+			Termination: "2024-04-15",
+			Frequency:   date.Monthly,
+			Options:     []date.ScheduleOption{date.OptScheduleDirection(date.RollForward), date.OptScheduleFrontStub(date.StubShort), date.OptScheduleBackStub(date.StubLong)},
+			Expected:    []string{"[2024-01-01,2024-02-01)", "[2024-02-01,2024-03-01)", "[2024-03-01,2024-04-15)"},
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			effective := mustDate(assert, tc.Effective)
+			termination := mustDate(assert, tc.Termination)
+			periods, err := date.GenerateSchedule(effective, termination, tc.Frequency, tc.Options...)
+			assert.Nil(err)
+
+			computed := make([]string, len(periods))
+			for j, period := range periods {
+				computed[j] = period.Unadjusted.String()
+				assert.Equal(period.Unadjusted, period.Adjusted)
+			}
+			assert.Equal(tc.Expected, computed)
+		})
+	}
+}
+
+func TestGenerateSchedule_Adjusted(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	c, err := date.NewCalendar(date.OptCalendarHolidaySets(date.SIFMAHolidays()))
+	assert.Nil(err)
+
+	periods, err := date.GenerateSchedule(
+		mustDate(assert, "2024-05-31"),
+		mustDate(assert, "2024-11-30"),
+		date.Monthly,
+		date.OptScheduleEndOfMonth(),
+		date.OptScheduleAdjustment(c, date.ModifiedFollowing),
+	)
+	assert.Nil(err)
+
+	unadjusted := make([]string, len(periods))
+	adjusted := make([]string, len(periods))
+	for i, period := range periods {
+		unadjusted[i] = period.Unadjusted.String()
+		adjusted[i] = period.Adjusted.String()
+	}
+
+	expected := []string{
+		"[2024-05-31,2024-06-30)",
+		"[2024-06-30,2024-07-31)",
+		"[2024-07-31,2024-08-31)",
+		"[2024-08-31,2024-09-30)",
+		"[2024-09-30,2024-10-31)",
+		"[2024-10-31,2024-11-30)",
+	}
+	assert.Equal(expected, unadjusted)
+	expected = []string{
+		"[2024-05-31,2024-06-28)",
+		"[2024-06-28,2024-07-31)",
+		"[2024-07-31,2024-08-30)",
+		"[2024-08-30,2024-09-30)",
+		"[2024-09-30,2024-10-31)",
+		"[2024-10-31,2024-11-29)",
+	}
+	assert.Equal(expected, adjusted)
+}
+
+func TestGenerateSchedule_Errors(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	effective := mustDate(assert, "2024-01-01")
+	termination := mustDate(assert, "2025-01-01")
+
+	periods, err := date.GenerateSchedule(termination, effective, date.Monthly)
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("schedule effective date must be before termination date; effective=2025-01-01, termination=2024-01-01", fmt.Sprintf("%v", err))
+
+	periods, err = date.GenerateSchedule(effective, date.Infinity, date.Monthly, date.OptScheduleDirection(date.RollForward))
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("schedule dates must be finite; effective=2024-01-01, termination=infinity", fmt.Sprintf("%v", err))
+
+	periods, err = date.GenerateSchedule(date.NegativeInfinity, termination, date.Monthly)
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("schedule dates must be finite; effective=-infinity, termination=2025-01-01", fmt.Sprintf("%v", err))
+
+	periods, err = date.GenerateSchedule(effective, termination, date.Frequency(0))
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("unsupported schedule frequency; Frequency(0)", fmt.Sprintf("%v", err))
+
+	periods, err = date.GenerateSchedule(effective, termination, date.Monthly, date.OptScheduleDirection(date.RollDirection(7)))
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("unsupported schedule roll direction; 7", fmt.Sprintf("%v", err))
+
+	periods, err = date.GenerateSchedule(effective, termination, date.Monthly, date.OptScheduleFirstRegularDate(mustDate(assert, "2023-12-15")))
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("schedule first regular date must be within the schedule; effective=2024-01-01, first=2023-12-15, termination=2025-01-01", fmt.Sprintf("%v", err))
+
+	periods, err = date.GenerateSchedule(effective, termination, date.Monthly, date.OptScheduleLastRegularDate(mustDate(assert, "2025-01-15")))
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("schedule last regular date must be within the schedule; effective=2024-01-01, last=2025-01-15, termination=2025-01-01", fmt.Sprintf("%v", err))
+
+	periods, err = date.GenerateSchedule(
+		effective,
+		termination,
+		date.Monthly,
+		date.OptScheduleFirstRegularDate(mustDate(assert, "2024-06-15")),
+		date.OptScheduleLastRegularDate(mustDate(assert, "2024-03-15")),
+	)
+	assert.Nil(periods)
+	assert.NotNil(err)
+	assert.Equal("schedule first regular date must be before last regular date; first=2024-06-15, last=2024-03-15", fmt.Sprintf("%v", err))
+}
+
+func TestFrequency_String(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	assert.Equal("Weekly", date.Weekly.String())
+	assert.Equal("Biweekly", date.Biweekly.String())
+	assert.Equal("Monthly", date.Monthly.String())
+	assert.Equal("Quarterly", date.Quarterly.String())
+	assert.Equal("Semiannual", date.Semiannual.String())
+	assert.Equal("Annual", date.Annual.String())
+	assert.Equal("Frequency(0)", date.Frequency(0).String())
+}
@@ -0,0 +1,314 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NOTE: Ensure that
+// - `Tenor` satisfies `fmt.Stringer`.
+// - `Tenor` satisfies `encoding.TextMarshaler`.
+// - `Tenor` satisfies `json.Marshaler`.
+// - `*Tenor` satisfies `encoding.TextUnmarshaler`.
+// - `*Tenor` satisfies `json.Unmarshaler`.
+var (
+	_ fmt.Stringer             = Tenor{}
+	_ encoding.TextMarshaler   = Tenor{}
+	_ json.Marshaler           = Tenor{}
+	_ encoding.TextUnmarshaler = (*Tenor)(nil)
+	_ json.Unmarshaler         = (*Tenor)(nil)
+)
+
+// TenorUnit is the unit of a `Tenor`.
+type TenorUnit int
+
+const (
+	// TenorOvernight is the overnight (`ON`) tenor, i.e. 1 business day.
+	TenorOvernight TenorUnit = iota + 1
+	// TenorTomNext is the tom-next (`TN`) tenor, i.e. the day after tomorrow;
+	// 2 business days.
+	TenorTomNext
+	// TenorSpot is the spot (`SPOT`) tenor, i.e. 2 business days.
+	TenorSpot
+	// TenorDays is a number of calendar days, e.g. `10D`.
+	TenorDays
+	// TenorWeeks is a number of weeks, e.g. `2W`.
+	TenorWeeks
+	// TenorMonths is a number of months, e.g. `3M`.
+	TenorMonths
+	// TenorYears is a number of years, e.g. `10Y`.
+	TenorYears
+)
+
+// String implements `fmt.Stringer`.
+func (tu TenorUnit) String() string {
+	switch tu {
+	case TenorOvernight:
+		return "ON"
+	case TenorTomNext:
+		return "TN"
+	case TenorSpot:
+		return "SPOT"
+	case TenorDays:
+		return "D"
+	case TenorWeeks:
+		return "W"
+	case TenorMonths:
+		return "M"
+	case TenorYears:
+		return "Y"
+	default:
+		return fmt.Sprintf("TenorUnit(%d)", int(tu))
+	}
+}
+
+// Tenor is a standard market tenor (i.e. the term of a loan, deposit or FX
+// trade) such as `ON`, `TN`, `SPOT`, `1W`, `3M` or `10Y`. This is intended to
+// be serialized in that form, e.g. in configuration files.
+//
+// For `TenorOvernight`, `TenorTomNext` and `TenorSpot` the `Amount` is unused
+// and should be 0.
+type Tenor struct {
+	Amount int
+	Unit   TenorUnit
+}
+
+var (
+	// Overnight is the overnight (`ON`) tenor.
+	Overnight = Tenor{Unit: TenorOvernight}
+	// TomNext is the tom-next (`TN`) tenor.
+	TomNext = Tenor{Unit: TenorTomNext}
+	// Spot is the spot (`SPOT`) tenor.
+	Spot = Tenor{Unit: TenorSpot}
+)
+
+// NewTenor returns a new `Tenor` struct. This is a pure convenience function
+// to make it more ergonomic to create a `Tenor` struct.
+func NewTenor(amount int, unit TenorUnit) Tenor {
+	return Tenor{Amount: amount, Unit: unit}
+}
+
+// ParseTenor parses a tenor such as `ON`, `TN`, `SPOT`, `1W`, `3M` or `10Y`;
+// this is case-insensitive. The forms `O/N`, `T/N` and `SP` are also accepted.
+func ParseTenor(s string) (Tenor, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(s))
+	switch normalized {
+	case "ON", "O/N":
+		return Overnight, nil
+	case "TN", "T/N":
+		return TomNext, nil
+	case "SPOT", "SP":
+		return Spot, nil
+	}
+
+	if len(normalized) < 2 {
+		return Tenor{}, fmt.Errorf("invalid tenor; %q", s)
+	}
+
+	var unit TenorUnit
+	switch normalized[len(normalized)-1] {
+	case 'D':
+		unit = TenorDays
+	case 'W':
+		unit = TenorWeeks
+	case 'M':
+		unit = TenorMonths
+	case 'Y':
+		unit = TenorYears
+	default:
+		return Tenor{}, fmt.Errorf("invalid tenor; %q", s)
+	}
+
+	amountStr := normalized[:len(normalized)-1]
+	if amountStr[0] < '0' || amountStr[0] > '9' {
+		return Tenor{}, fmt.Errorf("invalid tenor; %q", s)
+	}
+	amount, err := strconv.Atoi(amountStr)
+	if err != nil {
+		return Tenor{}, fmt.Errorf("invalid tenor; %q", s)
+	}
+
+	return Tenor{Amount: amount, Unit: unit}, nil
+}
+
+// IsZero returns true if the tenor is the zero value.
+func (t Tenor) IsZero() bool {
+	return t == Tenor{}
+}
+
+// String implements `fmt.Stringer`; formats the tenor as e.g. `ON`, `3M` or
+// `10Y`. The zero value is formatted as an empty string.
+func (t Tenor) String() string {
+	switch t.Unit {
+	case 0:
+		return ""
+	case TenorOvernight, TenorTomNext, TenorSpot:
+		return t.Unit.String()
+	default:
+		return fmt.Sprintf("%d%s", t.Amount, t.Unit)
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t Tenor) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the tenor as e.g. `3M`.
+func (t Tenor) MarshalJSON() ([]byte, error) {
+	s := t.String()
+	return json.Marshal(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. An empty
+// string (as produced by `MarshalText()` for the zero value) is unmarshaled
+// to the zero value.
+func (t *Tenor) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*t = Tenor{}
+		return nil
+	}
+
+	parsed, err := ParseTenor(string(data))
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`; parses the tenor as e.g. `3M`.
+// An empty string (as produced by `MarshalJSON()` for the zero value) is
+// unmarshaled to the zero value.
+func (t *Tenor) UnmarshalJSON(data []byte) error {
+	s := ""
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	if s == "" {
+		*t = Tenor{}
+		return nil
+	}
+
+	parsed, err := ParseTenor(s)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// TenorConfig helps customize the behavior of `AddTenor()`.
+type TenorConfig struct {
+	EndOfMonth bool
+	Calendar   BusinessCalendar
+	Convention BusinessDayConvention
+}
+
+// TenorOption defines a function that will be applied to a tenor config.
+type TenorOption func(*TenorConfig)
+
+// OptTenorEndOfMonth returns an option that enables the end-of-month rule on a
+// tenor config. When enabled, applying a month or year tenor to the last day
+// of a month results in the last day of the target month (e.g. 1M from
+// 2024-04-30 is 2024-05-31 rather than 2024-05-30).
+func OptTenorEndOfMonth() TenorOption {
+	return func(tc *TenorConfig) {
+		tc.EndOfMonth = true
+	}
+}
+
+// OptTenorAdjustment returns an option that sets the business calendar and
+// business day convention on a tenor config. The calendar is used to count
+// business days for `ON`, `TN` and `SPOT` and (with the convention) to adjust
+// the result of a day, week, month or year tenor.
+func OptTenorAdjustment(calendar BusinessCalendar, convention BusinessDayConvention) TenorOption {
+	return func(tc *TenorConfig) {
+		tc.Calendar = calendar
+		tc.Convention = convention
+	}
+}
+
+// AddTenor returns the date corresponding to applying the tenor to the date.
+//
+// The tenors `ON`, `TN` and `SPOT` add 1, 2 and 2 business days, respectively.
+// By default, business days are Monday through Friday; use
+// `OptTenorAdjustment()` to provide a calendar with holidays. Day and week
+// tenors add calendar days and month and year tenors add months via
+// `AddMonths()` (so the day is clamped to the end of a shorter target month).
+// The result of a day, week, month or year tenor is then adjusted according to
+// the business day convention (if any).
+//
+// For example:
+// - `SPOT` from 2024-03-01 (Friday) is 2024-03-05
+// - `1M` from 2024-01-31 is 2024-02-29
+// - `1M` from 2024-04-30 is 2024-05-30 (or 2024-05-31 with the end-of-month rule)
+func (d Date) AddTenor(t Tenor, opts ...TenorOption) Date {
+	if d.IsInfinite() {
+		return d
+	}
+
+	tc := TenorConfig{}
+	for _, opt := range opts {
+		opt(&tc)
+	}
+	calendar := tc.Calendar
+	if calendar == nil {
+		calendar = weekendCalendar()
+	}
+
+	switch t.Unit {
+	case TenorOvernight:
+		return calendar.AddBusinessDays(d, 1)
+	case TenorTomNext, TenorSpot:
+		return calendar.AddBusinessDays(d, 2)
+	}
+
+	result := d
+	switch t.Unit {
+	case TenorDays:
+		result = d.AddDays(t.Amount)
+	case TenorWeeks:
+		result = d.AddDays(7 * t.Amount)
+	case TenorMonths, TenorYears:
+		months := t.Amount
+		if t.Unit == TenorYears {
+			months = 12 * t.Amount
+		}
+		result = d.AddMonths(months)
+		if tc.EndOfMonth && d.Equal(d.MonthEnd()) {
+			result = result.MonthEnd()
+		}
+	}
+
+	return Adjust(result, calendar, tc.Convention)
+}
+
+// weekendCalendar returns a calendar with a Saturday / Sunday weekend and no
+// holidays.
+func weekendCalendar() *Calendar {
+	c, err := NewCalendar()
+	mustNil(err)
+	return c
+}
@@ -0,0 +1,235 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestParseTenor(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input    string
+		Expected date.Tenor
+		String   string
+		Error    string
+	}
+
+	cases := []testCase{
+		{Input: "ON", Expected: date.Overnight, String: "ON"},
+		{Input: "o/n", Expected: date.Overnight, String: "ON"},
+		{Input: "TN", Expected: date.TomNext, String: "TN"},
+		{Input: "T/N", Expected: date.TomNext, String: "TN"},
+		{Input: "SPOT", Expected: date.Spot, String: "SPOT"},
+		{Input: "sp", Expected: date.Spot, String: "SPOT"},
+		{Input: "1D", Expected: date.NewTenor(1, date.TenorDays), String: "1D"},
+		{Input: "1w", Expected: date.NewTenor(1, date.TenorWeeks), String: "1W"},
+		{Input: " 3M ", Expected: date.NewTenor(3, date.TenorMonths), String: "3M"},
+		{Input: "18M", Expected: date.NewTenor(18, date.TenorMonths), String: "18M"},
+		{Input: "10Y", Expected: date.NewTenor(10, date.TenorYears), String: "10Y"},
+		{Input: "0D", Expected: date.NewTenor(0, date.TenorDays), String: "0D"},
+		{Input: "", Error: `invalid tenor; ""`},
+		{Input: "M", Error: `invalid tenor; "M"`},
+		{Input: "3Q", Error: `invalid tenor; "3Q"`},
+		{Input: "-3M", Error: `invalid tenor; "-3M"`},
+		{Input: "+3M", Error: `invalid tenor; "+3M"`},
+		{Input: "3.5Y", Error: `invalid tenor; "3.5Y"`},
+		{Input: "P3M", Error: `invalid tenor; "P3M"`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			tenor, err := date.ParseTenor(tc.Input)
+			if tc.Error != "" {
+				assert.Equal(date.Tenor{}, tenor)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Expected, tenor)
+			assert.Equal(tc.String, tenor.String())
+		})
+	}
+}
+
+func TestTenor_String(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	assert.Equal("", date.Tenor{}.String())
+	assert.True(date.Tenor{}.IsZero())
+	assert.False(date.Spot.IsZero())
+	assert.Equal("TenorUnit(99)", date.TenorUnit(99).String())
+	assert.Equal("2TenorUnit(99)", date.NewTenor(2, 99).String())
+}
+
+func TestTenor_JSON(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	type config struct {
+		Tenors []date.Tenor `json:"tenors"`
+	}
+
+	c := config{Tenors: []date.Tenor{date.Overnight, date.Spot, date.NewTenor(18, date.TenorMonths)}}
+	asJSON, err := json.Marshal(c)
+	assert.Nil(err)
+	assert.Equal(`{"tenors":["ON","SPOT","18M"]}`, string(asJSON))
+
+	parsed := config{}
+	err = json.Unmarshal(asJSON, &parsed)
+	assert.Nil(err)
+	assert.Equal(c, parsed)
+
+	err = json.Unmarshal([]byte(`{"tenors":["3Q"]}`), &parsed)
+	assert.Equal(`invalid tenor; "3Q"`, fmt.Sprintf("%v", err))
+
+	asText, err := date.NewTenor(2, date.TenorWeeks).MarshalText()
+	assert.Nil(err)
+	assert.Equal("2W", string(asText))
+
+	tenor := date.Tenor{}
+	err = tenor.UnmarshalText([]byte("tn"))
+	assert.Nil(err)
+	assert.Equal(date.TomNext, tenor)
+
+	// Zero value round trip
+	type optional struct {
+		Tenor date.Tenor `json:"tenor"`
+	}
+	asJSON, err = json.Marshal(optional{})
+	assert.Nil(err)
+	assert.Equal(`{"tenor":""}`, string(asJSON))
+	unset := optional{Tenor: date.Spot}
+	err = json.Unmarshal(asJSON, &unset)
+	assert.Nil(err)
+	assert.Equal(optional{}, unset)
+
+	asText, err = date.Tenor{}.MarshalText()
+	assert.Nil(err)
+	assert.Equal("", string(asText))
+	err = tenor.UnmarshalText(asText)
+	assert.Nil(err)
+	assert.Equal(date.Tenor{}, tenor)
+
+	// `ParseTenor()` still rejects an empty string
+	_, err = date.ParseTenor("")
+	assert.Equal(`invalid tenor; ""`, fmt.Sprintf("%v", err))
+}
+
+func TestDate_AddTenor(base *testing.T) {
+	base.Parallel()
+
+	holidays, err := date.NewCalendar(date.OptCalendarHolidays(
+		date.NewDate(2024, time.March, 29),
+		date.NewDate(2024, time.April, 1),
+	))
+	if err != nil {
+		base.Fatal(err)
+	}
+
+	type testCase struct {
+		Date     string
+		Tenor    string
+		Options  []date.TenorOption
+		Expected string
+	}
+
+	cases := []testCase{
+		{Date: "2024-03-04", Tenor: "ON", Expected: "2024-03-05"},
+		{Date: "2024-03-01", Tenor: "ON", Expected: "2024-03-04"},
+		{Date: "2024-03-01", Tenor: "TN", Expected: "2024-03-05"},
+		{Date: "2024-03-01", Tenor: "SPOT", Expected: "2024-03-05"},
+		{Date: "2024-03-02", Tenor: "SPOT", Expected: "2024-03-05"},
+		{
+			Date:     "2024-03-27",
+			Tenor:    "SPOT",
+			Options:  []date.TenorOption{date.OptTenorAdjustment(holidays, date.Following)},
+			Expected: "2024-04-02",
+		},
+		{Date: "2024-03-04", Tenor: "10D", Expected: "2024-03-14"},
+		{Date: "2024-03-04", Tenor: "2W", Expected: "2024-03-18"},
+		{Date: "2024-01-31", Tenor: "1M", Expected: "2024-02-29"},
+		{Date: "2024-01-31", Tenor: "18M", Expected: "2025-07-31"},
+		{Date: "2024-02-29", Tenor: "1Y", Expected: "2025-02-28"},
+		{Date: "2024-04-30", Tenor: "1M", Expected: "2024-05-30"},
+		{
+			Date:     "2024-04-30",
+			Tenor:    "1M",
+			Options:  []date.TenorOption{date.OptTenorEndOfMonth()},
+			Expected: "2024-05-31",
+		},
+		{
+			Date:     "2023-02-28",
+			Tenor:    "1Y",
+			Options:  []date.TenorOption{date.OptTenorEndOfMonth()},
+			Expected: "2024-02-29",
+		},
+		{
+			Date:     "2024-04-29",
+			Tenor:    "1M",
+			Options:  []date.TenorOption{date.OptTenorEndOfMonth()},
+			Expected: "2024-05-29",
+		},
+		{Date: "2024-03-04", Tenor: "5D", Expected: "2024-03-09"},
+		{
+			Date:     "2024-03-04",
+			Tenor:    "5D",
+			Options:  []date.TenorOption{date.OptTenorAdjustment(holidays, date.Following)},
+			Expected: "2024-03-11",
+		},
+		{
+			Date:     "2024-02-29",
+			Tenor:    "1M",
+			Options:  []date.TenorOption{date.OptTenorAdjustment(holidays, date.ModifiedFollowing)},
+			Expected: "2024-03-28",
+		},
+		{Date: "infinity", Tenor: "3M", Expected: "infinity"},
+		{Date: "infinity", Tenor: "SPOT", Expected: "infinity"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s + %s (%d options)", tc.Date, tc.Tenor, len(tc.Options))
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			d := mustDate(assert, tc.Date)
+			tenor, err := date.ParseTenor(tc.Tenor)
+			assert.Nil(err)
+
+			computed := d.AddTenor(tenor, tc.Options...)
+			assert.Equal(tc.Expected, computed.String())
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// NOTE: Ensure that
+// - `StrictDate` satisfies `encoding.TextMarshaler`.
+// - `StrictDate` satisfies `json.Marshaler`.
+// - `StrictDate` satisfies `driver.Valuer`.
+// - `NullStrictDate` satisfies `encoding.TextMarshaler`.
+// - `NullStrictDate` satisfies `json.Marshaler`.
+// - `NullStrictDate` satisfies `driver.Valuer`.
+var (
+	_ encoding.TextMarshaler = StrictDate{}
+	_ json.Marshaler         = StrictDate{}
+	_ driver.Valuer          = StrictDate{}
+	_ encoding.TextMarshaler = NullStrictDate{}
+	_ json.Marshaler         = NullStrictDate{}
+	_ driver.Valuer          = NullStrictDate{}
+)
+
+// NewDateStrict returns a new `Date` struct, or an error if the month or day is
+// out of range (e.g. 2024-02-30). `NewDate()`, on the other hand, accepts any
+// values and they are normalized when the date is converted to a `time.Time{}`.
+func NewDateStrict(year int, month time.Month, day int) (Date, error) {
+	d := Date{Year: year, Month: month, Day: day}
+	err := d.Validate()
+	if err != nil {
+		return Date{}, err
+	}
+
+	return d, nil
+}
+
+// IsValid returns true if the month and day are in range for the year, i.e.
+// the date will not be normalized when converted to a `time.Time{}`. The
+// infinite dates `Infinity` and `NegativeInfinity` are valid.
+func (d Date) IsValid() bool {
+	return d.Validate() == nil
+}
+
+// Validate returns an error wrapping `ErrInvalidDate` if the month or day is
+// out of range for the year (e.g. 2024-02-30 or month 13). The infinite dates
+// `Infinity` and `NegativeInfinity` are valid.
+func (d Date) Validate() error {
+	if d.IsInfinite() {
+		return nil
+	}
+
+	if d.Month < time.January || d.Month > time.December {
+		return fmt.Errorf("%w; month out of range; year=%d, month=%d, day=%d", ErrInvalidDate, d.Year, d.Month, d.Day)
+	}
+
+	if d.Day < 1 || d.Day > daysIn(d.Month, d.Year) {
+		return fmt.Errorf("%w; day out of range; year=%d, month=%d, day=%d", ErrInvalidDate, d.Year, d.Month, d.Day)
+	}
+
+	return nil
+}
+
+// StrictDate is a `Date{}` that is validated whenever it is serialized; i.e.
+// `MarshalText()`, `MarshalJSON()` and `Value()` return the error from
+// `Validate()` for a date that is not valid rather than serializing the
+// normalized date (e.g. 2024-02-30 as 2024-03-01).
+type StrictDate struct {
+	Date
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. An invalid date
+// returns an error.
+func (sd StrictDate) MarshalText() ([]byte, error) {
+	err := sd.Date.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return sd.Date.MarshalText()
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the date as YYYY-MM-DD. An
+// invalid date returns an error.
+func (sd StrictDate) MarshalJSON() ([]byte, error) {
+	err := sd.Date.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return sd.Date.MarshalJSON()
+}
+
+// Value implements `driver.Valuer`; it marshals the value as `Date{}.Value()`
+// does. An invalid date returns an error.
+func (sd StrictDate) Value() (driver.Value, error) {
+	err := sd.Date.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return sd.Date.Value()
+}
+
+// NullStrictDate is a `NullDate{}` that is validated whenever a non-null value
+// is serialized, as with `StrictDate{}`.
+type NullStrictDate struct {
+	NullDate
+}
+
+// MarshalText implements the encoding.TextMarshaler interface; a null date is
+// formatted as an empty string. An invalid date returns an error.
+func (nsd NullStrictDate) MarshalText() ([]byte, error) {
+	if !nsd.Valid {
+		return []byte{}, nil
+	}
+
+	return StrictDate{Date: nsd.Date}.MarshalText()
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the date as YYYY-MM-DD or
+// `null`. An invalid date returns an error.
+func (nsd NullStrictDate) MarshalJSON() ([]byte, error) {
+	if !nsd.Valid {
+		return []byte("null"), nil
+	}
+
+	return StrictDate{Date: nsd.Date}.MarshalJSON()
+}
+
+// Value implements `driver.Valuer`; it marshals the value as
+// `NullDate{}.Value()` does. An invalid date returns an error.
+func (nsd NullStrictDate) Value() (driver.Value, error) {
+	if !nsd.Valid {
+		return nil, nil
+	}
+
+	return StrictDate{Date: nsd.Date}.Value()
+}
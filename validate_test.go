@@ -0,0 +1,173 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestDate_Validate(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Date  date.Date
+		Error string
+	}
+
+	cases := []testCase{
+		{Date: date.NewDate(2024, time.February, 29)},
+		{Date: date.NewDate(2023, time.December, 31)},
+		{Date: date.NewDate(1, time.January, 1)},
+		{Date: date.Infinity},
+		{Date: date.NegativeInfinity},
+		{
+			Date:  date.NewDate(2024, time.February, 30),
+			Error: "invalid date; day out of range; year=2024, month=2, day=30",
+		},
+		{
+			Date:  date.NewDate(2023, time.February, 29),
+			Error: "invalid date; day out of range; year=2023, month=2, day=29",
+		},
+		{
+			Date:  date.NewDate(2024, time.April, 0),
+			Error: "invalid date; day out of range; year=2024, month=4, day=0",
+		},
+		{
+			Date:  date.NewDate(2024, time.Month(13), 1),
+			Error: "invalid date; month out of range; year=2024, month=13, day=1",
+		},
+		{
+			Date:  date.Date{},
+			Error: "invalid date; month out of range; year=0, month=0, day=0",
+		},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Date.GoString(), func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			err := tc.Date.Validate()
+			if tc.Error == "" {
+				assert.Nil(err)
+				assert.True(tc.Date.IsValid())
+				return
+			}
+
+			assert.False(tc.Date.IsValid())
+			assert.True(errors.Is(err, date.ErrInvalidDate))
+			assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+		})
+	}
+}
+
+func TestNewDateStrict(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	d, err := date.NewDateStrict(2024, time.February, 29)
+	assert.Nil(err)
+	assert.Equal(date.NewDate(2024, time.February, 29), d)
+
+	d, err = date.NewDateStrict(2024, time.February, 30)
+	assert.Equal(date.Date{}, d)
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+	assert.Equal("invalid date; day out of range; year=2024, month=2, day=30", fmt.Sprintf("%v", err))
+}
+
+func TestStrictDate(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	invalid := date.NewDate(2024, time.February, 30)
+
+	// `Date{}` normalizes invalid dates.
+	asJSON, err := json.Marshal(invalid)
+	assert.Nil(err)
+	assert.Equal(`"2024-03-01"`, string(asJSON))
+
+	asJSON, err = json.Marshal(date.StrictDate{Date: invalid})
+	assert.Nil(asJSON)
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+
+	asText, err := date.StrictDate{Date: invalid}.MarshalText()
+	assert.Nil(asText)
+	assert.Equal("invalid date; day out of range; year=2024, month=2, day=30", fmt.Sprintf("%v", err))
+
+	v, err := date.StrictDate{Date: invalid}.Value()
+	assert.Nil(v)
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+
+	// Valid dates are serialized as usual.
+	valid := date.StrictDate{Date: date.NewDate(2024, time.February, 29)}
+	asJSON, err = json.Marshal(valid)
+	assert.Nil(err)
+	assert.Equal(`"2024-02-29"`, string(asJSON))
+	asText, err = valid.MarshalText()
+	assert.Nil(err)
+	assert.Equal("2024-02-29", string(asText))
+	v, err = valid.Value()
+	assert.Nil(err)
+	assert.Equal(valid.ToTime(), v)
+
+	// Round trip
+	parsed := date.StrictDate{}
+	err = json.Unmarshal([]byte(`"2024-02-29"`), &parsed)
+	assert.Nil(err)
+	assert.Equal(valid, parsed)
+}
+
+func TestNullStrictDate(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	invalid := date.NullStrictDate{NullDate: date.NullDate{Date: date.NewDate(2024, time.February, 30), Valid: true}}
+	asJSON, err := json.Marshal(invalid)
+	assert.Nil(asJSON)
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+
+	asText, err := invalid.MarshalText()
+	assert.Nil(asText)
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+
+	v, err := invalid.Value()
+	assert.Nil(v)
+	assert.True(errors.Is(err, date.ErrInvalidDate))
+
+	// Invalid null dates are still serialized as null.
+	null := date.NullStrictDate{NullDate: date.NullDate{Date: date.NewDate(2024, time.February, 30)}}
+	asJSON, err = json.Marshal(null)
+	assert.Nil(err)
+	assert.Equal("null", string(asJSON))
+
+	asText, err = null.MarshalText()
+	assert.Nil(err)
+	assert.Equal("", string(asText))
+
+	v, err = null.Value()
+	assert.Nil(err)
+	assert.Nil(v)
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NOTE: Ensure that
+// - `YearQuarter` satisfies `fmt.Stringer`.
+// - `YearQuarter` satisfies `encoding.TextMarshaler`.
+// - `YearQuarter` satisfies `json.Marshaler`.
+// - `*YearQuarter` satisfies `encoding.TextUnmarshaler`.
+// - `*YearQuarter` satisfies `json.Unmarshaler`.
+// - `*YearQuarter` satisfies `sql.Scanner`.
+// - `YearQuarter` satisfies `driver.Valuer`.
+var (
+	_ fmt.Stringer             = YearQuarter{}
+	_ encoding.TextMarshaler   = YearQuarter{}
+	_ json.Marshaler           = YearQuarter{}
+	_ encoding.TextUnmarshaler = (*YearQuarter)(nil)
+	_ json.Unmarshaler         = (*YearQuarter)(nil)
+	_ sql.Scanner              = (*YearQuarter)(nil)
+	_ driver.Valuer            = YearQuarter{}
+)
+
+// YearQuarter is a quarter of a specific year, e.g. the third quarter of
+// 2024. This is intended to be serialized as YYYY-QN, e.g. `2024-Q3`.
+type YearQuarter struct {
+	Year    int
+	Quarter int
+}
+
+// NewYearQuarter returns a new `YearQuarter` struct. This is a pure
+// convenience function to make it more ergonomic to create a `YearQuarter`
+// struct.
+func NewYearQuarter(year, quarter int) YearQuarter {
+	return YearQuarter{Year: year, Quarter: quarter}
+}
+
+// YearQuarter returns the quarter (of a specific year) of the current date.
+func (d Date) YearQuarter() YearQuarter {
+	return YearQuarter{Year: d.Year, Quarter: d.Quarter()}
+}
+
+// YearQuarterFromString parses a string of the form YYYY-QN (e.g. `2024-Q3`)
+// into a `YearQuarter{}`; this is case-insensitive and the `-` is optional.
+func YearQuarterFromString(s string) (YearQuarter, error) {
+	yearStr, quarterStr, ok := strings.Cut(strings.ToUpper(s), "Q")
+	yearStr = strings.TrimSuffix(yearStr, "-")
+	if !ok || len(yearStr) != 4 || len(quarterStr) != 1 {
+		return YearQuarter{}, fmt.Errorf("invalid year quarter; %q", s)
+	}
+
+	year, err := strconv.Atoi(yearStr)
+	if err != nil || yearStr[0] < '0' || yearStr[0] > '9' {
+		return YearQuarter{}, fmt.Errorf("invalid year quarter; %q", s)
+	}
+
+	quarter := int(quarterStr[0] - '0')
+	if quarter < 1 || quarter > 4 {
+		return YearQuarter{}, fmt.Errorf("invalid year quarter; %q", s)
+	}
+
+	return YearQuarter{Year: year, Quarter: quarter}, nil
+}
+
+// Start returns the first date in the quarter.
+func (yq YearQuarter) Start() Date {
+	month := time.Month(3*(yq.Quarter-1) + 1)
+	return Date{Year: yq.Year, Month: month, Day: 1}
+}
+
+// End returns the last date in the quarter.
+func (yq YearQuarter) End() Date {
+	return yq.Start().QuarterEnd()
+}
+
+// DateRange returns the dates in the quarter as a (half-open) `DateRange`,
+// i.e. from the start of this quarter to the start of the next quarter.
+func (yq YearQuarter) DateRange() DateRange {
+	return NewDateRange(yq.Start(), yq.AddQuarters(1).Start())
+}
+
+// Contains returns true if the date is in the quarter.
+func (yq YearQuarter) Contains(d Date) bool {
+	return d.YearQuarter() == yq
+}
+
+// AddQuarters returns the quarter corresponding to adding the given number of
+// quarters, e.g. adding 2 quarters to 2024-Q3 results in 2025-Q1.
+func (yq YearQuarter) AddQuarters(quarters int) YearQuarter {
+	index := 4*yq.Year + (yq.Quarter - 1) + quarters
+	year := index / 4
+	quarter := index%4 + 1
+	if quarter < 1 {
+		year--
+		quarter += 4
+	}
+	return YearQuarter{Year: year, Quarter: quarter}
+}
+
+// Before returns true if the quarter is before the other quarter.
+func (yq YearQuarter) Before(other YearQuarter) bool {
+	return yq.Compare(other) < 0
+}
+
+// After returns true if the quarter is after the other quarter.
+func (yq YearQuarter) After(other YearQuarter) bool {
+	return yq.Compare(other) > 0
+}
+
+// Equal returns true if the quarter is equal to the other quarter.
+func (yq YearQuarter) Equal(other YearQuarter) bool {
+	return yq == other
+}
+
+// Compare compares the quarter `yq` with `other`. If `yq` is before `other`,
+// it returns -1; if `yq` is after `other`, it returns +1; if they're the same,
+// it returns 0.
+func (yq YearQuarter) Compare(other YearQuarter) int {
+	if yq.Year != other.Year {
+		return compareInt(yq.Year, other.Year)
+	}
+
+	return compareInt(yq.Quarter, other.Quarter)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (yq YearQuarter) MarshalText() ([]byte, error) {
+	return []byte(yq.String()), nil
+}
+
+// MarshalJSON implements `json.Marshaler`; formats the quarter as YYYY-QN.
+func (yq YearQuarter) MarshalJSON() ([]byte, error) {
+	s := yq.String()
+	return json.Marshal(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The
+// quarter must be in the format YYYY-QN.
+func (yq *YearQuarter) UnmarshalText(data []byte) error {
+	parsed, err := YearQuarterFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*yq = parsed
+	return nil
+}
+
+// UnmarshalJSON implements `json.Unmarshaler`; parses the quarter as YYYY-QN.
+func (yq *YearQuarter) UnmarshalJSON(data []byte) error {
+	s := ""
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := YearQuarterFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*yq = parsed
+	return nil
+}
+
+// Scan implements `sql.Scanner`; it unmarshals values of the type `string` or
+// `[]byte` (in the format YYYY-QN) onto the current `YearQuarter` struct.
+func (yq *YearQuarter) Scan(src any) error {
+	var s string
+
+	switch srcTyped := src.(type) {
+	case string:
+		s = srcTyped
+	case []byte:
+		s = string(srcTyped)
+	default:
+		return fmt.Errorf("incompatible type for YearQuarter; type=%T", src)
+	}
+
+	parsed, err := YearQuarterFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*yq = parsed
+	return nil
+}
+
+// Value implements `driver.Valuer`; it marshals the value to a YYYY-QN string
+// to be serialized into the database.
+func (yq YearQuarter) Value() (driver.Value, error) {
+	return yq.String(), nil
+}
+
+// String implements `fmt.Stringer`; formats the quarter as YYYY-QN.
+func (yq YearQuarter) String() string {
+	return fmt.Sprintf("%04d-Q%d", yq.Year, yq.Quarter)
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 Hardfin, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	testifyrequire "github.com/stretchr/testify/require"
+
+	date "github.com/hardfinhq/go-date"
+)
+
+func TestYearQuarterFromString(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		Input    string
+		Expected date.YearQuarter
+		Error    string
+	}
+
+	cases := []testCase{
+		{Input: "2024-Q3", Expected: date.NewYearQuarter(2024, 3)},
+		{Input: "2024-q1", Expected: date.NewYearQuarter(2024, 1)},
+		{Input: "2024Q4", Expected: date.NewYearQuarter(2024, 4)},
+		{Input: "0999-Q2", Expected: date.NewYearQuarter(999, 2)},
+		{Input: "", Error: `invalid year quarter; ""`},
+		{Input: "2024-Q5", Error: `invalid year quarter; "2024-Q5"`},
+		{Input: "2024-Q0", Error: `invalid year quarter; "2024-Q0"`},
+		{Input: "2024-Q12", Error: `invalid year quarter; "2024-Q12"`},
+		{Input: "24-Q1", Error: `invalid year quarter; "24-Q1"`},
+		{Input: "+024-Q1", Error: `invalid year quarter; "+024-Q1"`},
+		{Input: "2024-03", Error: `invalid year quarter; "2024-03"`},
+		{Input: "Q3-2024", Error: `invalid year quarter; "Q3-2024"`},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		base.Run(tc.Input, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			yq, err := date.YearQuarterFromString(tc.Input)
+			if tc.Error != "" {
+				assert.Equal(date.YearQuarter{}, yq)
+				assert.Equal(tc.Error, fmt.Sprintf("%v", err))
+				return
+			}
+
+			assert.Nil(err)
+			assert.Equal(tc.Expected, yq)
+		})
+	}
+}
+
+func TestYearQuarter_Dates(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	yq := date.NewYearQuarter(2024, 1)
+	assert.Equal("2024-Q1", yq.String())
+	assert.Equal(date.NewDate(2024, time.January, 1), yq.Start())
+	assert.Equal(date.NewDate(2024, time.March, 31), yq.End())
+	assert.Equal("[2024-01-01,2024-04-01)", yq.DateRange().String())
+	assert.True(yq.Contains(date.NewDate(2024, time.February, 29)))
+	assert.False(yq.Contains(date.NewDate(2024, time.April, 1)))
+	assert.False(yq.Contains(date.NewDate(2023, time.January, 1)))
+
+	yq = date.NewYearQuarter(2023, 4)
+	assert.Equal(date.NewDate(2023, time.October, 1), yq.Start())
+	assert.Equal(date.NewDate(2023, time.December, 31), yq.End())
+	assert.Equal("[2023-10-01,2024-01-01)", yq.DateRange().String())
+
+	assert.Equal(date.NewYearQuarter(2024, 3), date.NewDate(2024, time.August, 15).YearQuarter())
+}
+
+func TestYearQuarter_AddQuarters(base *testing.T) {
+	base.Parallel()
+
+	type testCase struct {
+		YearQuarter string
+		Delta       int
+		Expected    string
+	}
+
+	cases := []testCase{
+		{YearQuarter: "2024-Q3", Delta: 0, Expected: "2024-Q3"},
+		{YearQuarter: "2024-Q3", Delta: 1, Expected: "2024-Q4"},
+		{YearQuarter: "2024-Q3", Delta: 2, Expected: "2025-Q1"},
+		{YearQuarter: "2024-Q3", Delta: 10, Expected: "2027-Q1"},
+		{YearQuarter: "2024-Q3", Delta: -2, Expected: "2024-Q1"},
+		{YearQuarter: "2024-Q3", Delta: -3, Expected: "2023-Q4"},
+		{YearQuarter: "2024-Q1", Delta: -8, Expected: "2022-Q1"},
+		{YearQuarter: "0000-Q1", Delta: -1, Expected: "-001-Q4"},
+	}
+
+	for i := range cases {
+		// NOTE: Assign to loop-local (instead of declaring the `tc` variable in
+		//       `range`) to avoid capturing reference to loop variable.
+		tc := cases[i]
+		description := fmt.Sprintf("%s %+d", tc.YearQuarter, tc.Delta)
+		base.Run(description, func(t *testing.T) {
+			t.Parallel()
+			assert := testifyrequire.New(t)
+
+			yq, err := date.YearQuarterFromString(tc.YearQuarter)
+			assert.Nil(err)
+			assert.Equal(tc.Expected, yq.AddQuarters(tc.Delta).String())
+		})
+	}
+}
+
+func TestYearQuarter_Compare(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	q1 := date.NewYearQuarter(2024, 1)
+	q3 := date.NewYearQuarter(2024, 3)
+	earlier := date.NewYearQuarter(2023, 4)
+
+	assert.True(q1.Before(q3))
+	assert.False(q3.Before(q1))
+	assert.True(earlier.Before(q1))
+	assert.True(q3.After(q1))
+	assert.False(q1.After(q1))
+	assert.True(q1.Equal(date.NewYearQuarter(2024, 1)))
+	assert.False(q1.Equal(q3))
+	assert.Equal(-1, q1.Compare(q3))
+	assert.Equal(1, q1.Compare(earlier))
+	assert.Equal(0, q1.Compare(q1))
+}
+
+func TestYearQuarter_Serialization(t *testing.T) {
+	t.Parallel()
+	assert := testifyrequire.New(t)
+
+	type report struct {
+		Period date.YearQuarter `json:"period"`
+	}
+
+	r := report{Period: date.NewYearQuarter(2024, 3)}
+	asJSON, err := json.Marshal(r)
+	assert.Nil(err)
+	assert.Equal(`{"period":"2024-Q3"}`, string(asJSON))
+
+	parsed := report{}
+	err = json.Unmarshal(asJSON, &parsed)
+	assert.Nil(err)
+	assert.Equal(r, parsed)
+
+	err = json.Unmarshal([]byte(`{"period":"2024-Q7"}`), &parsed)
+	assert.Equal(`invalid year quarter; "2024-Q7"`, fmt.Sprintf("%v", err))
+
+	asText, err := r.Period.MarshalText()
+	assert.Nil(err)
+	assert.Equal("2024-Q3", string(asText))
+
+	yq := date.YearQuarter{}
+	err = yq.UnmarshalText([]byte("2025-Q1"))
+	assert.Nil(err)
+	assert.Equal(date.NewYearQuarter(2025, 1), yq)
+
+	v, err := r.Period.Value()
+	assert.Nil(err)
+	assert.Equal("2024-Q3", v)
+
+	err = yq.Scan("2023-Q2")
+	assert.Nil(err)
+	assert.Equal(date.NewYearQuarter(2023, 2), yq)
+
+	err = yq.Scan([]byte("2022-Q4"))
+	assert.Nil(err)
+	assert.Equal(date.NewYearQuarter(2022, 4), yq)
+
+	err = yq.Scan(20224)
+	assert.Equal("incompatible type for YearQuarter; type=int", fmt.Sprintf("%v", err))
+}